@@ -0,0 +1,43 @@
+package cql
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Create a BulkUpdate that updates the fields of each of the loaded models (models previously obtained from the database)
+// with its own values, identifying each model by its primary key, inside transaction tx.
+//
+// The update is executed in a single statement (UPDATE ... FROM (VALUES ...) in postgres,
+// UPDATE ... CASE WHEN in mysql and sqlite and MERGE in sqlserver)
+// or in one statement per batch using ExecInBatches.
+// The updated at of the models is also set to the current time.
+//
+// If the models use optimistic locking (embed model.Versioned), each model is only updated
+// if its version was not modified since it was loaded, and its version is incremented.
+// Otherwise, ErrStaleObject is returned and none of the models is updated.
+//
+// For example:
+//
+//	products, err := cql.Query[models.Product](ctx, db).Find()
+//
+//	for _, product := range products {
+//		product.Int = product.Int * 2
+//	}
+//
+//	updated, err := cql.BulkUpdate(ctx, db, products, conditions.Product.Int).ExecInBatches(100)
+func BulkUpdate[T model.Model](
+	ctx context.Context,
+	tx *DB,
+	models []*T,
+	field condition.FieldOfModel[T],
+	fields ...condition.FieldOfModel[T],
+) *condition.BulkUpdate[T] {
+	return condition.NewBulkUpdate(
+		tx.gormDBWithContext(ctx),
+		models,
+		append([]condition.FieldOfModel[T]{field}, fields...),
+	)
+}
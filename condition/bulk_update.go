@@ -0,0 +1,576 @@
+package condition
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+
+	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// name of the derived table that contains the values of each model in postgres and sqlserver
+const bulkUpdateValuesTable = "cql_values"
+
+// name of the table updated by a MERGE statement in sqlserver
+const bulkUpdateTargetTable = "cql_target"
+
+// Update of a list of loaded models where each model is updated with its own values,
+// executed as a single statement (or one per batch)
+type BulkUpdate[T model.Model] struct {
+	tx     *gorm.DB
+	query  *CQLQuery
+	models []*T
+	fields []FieldOfModel[T]
+	err    error
+}
+
+// Exec executes the update of the models, returning the amount of rows updated
+//
+// WARNING: the value returned may depend on the db engine, for example mysql
+// only counts the rows whose values are actually changed
+func (bulkUpdate *BulkUpdate[T]) Exec() (int64, error) {
+	return bulkUpdate.exec(len(bulkUpdate.models))
+}
+
+// ExecInBatches executes the update of the models in batches of batchSize,
+// inside a transaction if more than one batch is needed,
+// returning the amount of rows updated.
+//
+// If batchSize is not greater than zero, ErrInvalidBatchSize is returned.
+//
+// WARNING: the value returned may depend on the db engine, for example mysql
+// only counts the rows whose values are actually changed
+func (bulkUpdate *BulkUpdate[T]) ExecInBatches(batchSize int) (int64, error) {
+	if batchSize <= 0 {
+		return 0, methodError(ErrInvalidBatchSize, "ExecInBatches")
+	}
+
+	return bulkUpdate.exec(batchSize)
+}
+
+func (bulkUpdate *BulkUpdate[T]) exec(batchSize int) (int64, error) {
+	if bulkUpdate.err != nil {
+		return 0, bulkUpdate.err
+	}
+
+	if len(bulkUpdate.models) == 0 {
+		return 0, nil
+	}
+
+	modelSchema, err := getSchema(bulkUpdate.tx, new(T))
+	if err != nil {
+		return 0, err
+	}
+
+	now := bulkUpdate.tx.NowFunc()
+	versionField := bulkUpdate.versionField(modelSchema)
+
+	var updated int64
+
+	execBatches := func(tx *gorm.DB) error {
+		for start := 0; start < len(bulkUpdate.models); start += batchSize {
+			end := min(start+batchSize, len(bulkUpdate.models))
+
+			result := bulkUpdate.statement(tx, modelSchema, bulkUpdate.models[start:end], now)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			// as the version of each updated row is incremented,
+			// the rows affected are the ones that matched in all the databases
+			if versionField != nil && result.RowsAffected < int64(end-start) {
+				return ErrStaleObject
+			}
+
+			updated += result.RowsAffected
+		}
+
+		return nil
+	}
+
+	if len(bulkUpdate.models) > batchSize || versionField != nil {
+		// all the batches are updated or none of them
+		// (for versioned models, none of them is updated if one of them is stale)
+		err = bulkUpdate.tx.Transaction(execBatches)
+	} else {
+		err = execBatches(bulkUpdate.tx)
+	}
+
+	if err != nil {
+		return 0, methodError(err, "BulkUpdate")
+	}
+
+	err = bulkUpdate.setUpdatedAt(modelSchema, now)
+	if err != nil {
+		return 0, err
+	}
+
+	err = bulkUpdate.incrementVersions(versionField)
+	if err != nil {
+		return 0, err
+	}
+
+	return updated, nil
+}
+
+// ToSQL returns the sql and values of the statement that Exec would execute, without executing it
+func (bulkUpdate *BulkUpdate[T]) ToSQL() (string, []any, error) {
+	if bulkUpdate.err != nil {
+		return "", nil, bulkUpdate.err
+	}
+
+	modelSchema, err := getSchema(bulkUpdate.tx, new(T))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return statementSQL(
+		bulkUpdate.statement(
+			dryRunSession(bulkUpdate.tx),
+			modelSchema,
+			bulkUpdate.models,
+			bulkUpdate.tx.NowFunc(),
+		),
+	)
+}
+
+// Sets the updated at field of the updated models to now
+func (bulkUpdate *BulkUpdate[T]) setUpdatedAt(modelSchema *schema.Schema, now any) error {
+	updatedAtField := bulkUpdate.updatedAtField(modelSchema)
+	if updatedAtField == nil {
+		return nil
+	}
+
+	ctx := bulkUpdate.tx.Statement.Context
+
+	for _, updatedModel := range bulkUpdate.models {
+		err := updatedAtField.Set(ctx, reflect.ValueOf(updatedModel).Elem(), now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Increments the version of the updated models, as it was incremented in the database
+func (bulkUpdate *BulkUpdate[T]) incrementVersions(versionField *schema.Field) error {
+	if versionField == nil {
+		return nil
+	}
+
+	ctx := bulkUpdate.tx.Statement.Context
+
+	for _, updatedModel := range bulkUpdate.models {
+		err := incrementVersion(ctx, versionField, reflect.ValueOf(updatedModel).Elem())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns the field that contains the version of the model
+// or nil if the model does not use optimistic locking
+func (bulkUpdate *BulkUpdate[T]) versionField(modelSchema *schema.Schema) *schema.Field {
+	versionColumn := versionColumnName(*new(T))
+	if versionColumn == "" {
+		return nil
+	}
+
+	return modelSchema.LookUpField(versionColumn)
+}
+
+// Returns the field that contains the updated at of the model
+// or nil if it does not have one or it is one of the updated fields
+func (bulkUpdate *BulkUpdate[T]) updatedAtField(modelSchema *schema.Schema) *schema.Field {
+	updatedAtColumn := (*new(T)).UpdatedAtColumnName()
+	if updatedAtColumn == "" {
+		return nil
+	}
+
+	for _, field := range bulkUpdate.fields {
+		if field.columnName(bulkUpdate.query, bulkUpdate.query.initialTable) == updatedAtColumn {
+			return nil
+		}
+	}
+
+	return modelSchema.LookUpField(updatedAtColumn)
+}
+
+// Returns the statement that updates models, executed in tx
+func (bulkUpdate *BulkUpdate[T]) statement(tx *gorm.DB, modelSchema *schema.Schema, models []*T, now any) *gorm.DB {
+	rows := bulkUpdateRows{
+		ctx:           tx.Statement.Context,
+		table:         bulkUpdate.query.initialTable.Name,
+		primaryFields: modelSchema.PrimaryFields,
+		fields:        make([]*schema.Field, 0, len(bulkUpdate.fields)),
+		models:        make([]reflect.Value, 0, len(models)),
+	}
+
+	for _, field := range bulkUpdate.fields {
+		columnName := field.columnName(bulkUpdate.query, bulkUpdate.query.initialTable)
+
+		schemaField := modelSchema.LookUpField(columnName)
+		if schemaField == nil {
+			return addError(tx, methodError(ErrFieldModelNotConcerned, "BulkUpdate"))
+		}
+
+		rows.fields = append(rows.fields, schemaField)
+	}
+
+	if updatedAtField := bulkUpdate.updatedAtField(modelSchema); updatedAtField != nil {
+		rows.updatedAtColumn = updatedAtField.DBName
+		rows.now = now
+	}
+
+	rows.versionField = bulkUpdate.versionField(modelSchema)
+
+	if softDeleteColumn := (*new(T)).SoftDeleteColumnName(); softDeleteColumn != "" {
+		rows.softDeleteColumn = bulkUpdate.query.ColumnName(bulkUpdate.query.initialTable, softDeleteColumn)
+	}
+
+	for _, updatedModel := range models {
+		rows.models = append(rows.models, reflect.ValueOf(updatedModel).Elem())
+	}
+
+	var statementSQL string
+
+	var values []any
+
+	switch bulkUpdate.query.Dialector() {
+	case sql.Postgres:
+		statementSQL, values = rows.updateFromValues(tx)
+	case sql.SQLServer:
+		statementSQL, values = rows.merge()
+	case sql.MySQL, sql.SQLite:
+		statementSQL, values = rows.updateWithCase()
+	}
+
+	return tx.Exec(statementSQL, values...)
+}
+
+// Returns tx with err added
+func addError(tx *gorm.DB, err error) *gorm.DB {
+	tx = tx.Session(&gorm.Session{})
+	_ = tx.AddError(err)
+
+	return tx
+}
+
+// Values of the models to be updated by a BulkUpdate
+type bulkUpdateRows struct {
+	ctx           context.Context
+	table         string
+	primaryFields []*schema.Field
+	fields        []*schema.Field
+	models        []reflect.Value
+	// column set to now in all the rows (empty if the model has no updated at)
+	updatedAtColumn string
+	now             any
+	// column that must be null for the row to be updated (empty if the model has no soft delete)
+	softDeleteColumn string
+	// field that must be equal to the version of the model for the row to be updated,
+	// incremented in all the rows (nil if the model does not use optimistic locking)
+	versionField *schema.Field
+}
+
+// UPDATE table SET column = cql_values.column FROM (VALUES (...), (...)) AS cql_values (...) WHERE table.pk = cql_values.pk
+//
+// Values are casted to the type of the column as postgres can not infer their type from a VALUES list
+func (rows bulkUpdateRows) updateFromValues(tx *gorm.DB) (string, []any) {
+	sets, values := rows.setsFromValues(clause.Column{Name: ""})
+	valuesSQL, valuesValues := rows.valuesList(func(field *schema.Field) string {
+		return "CAST(? AS " + columnDataType(tx, field) + ")"
+	})
+
+	values = append(values, valuesValues...)
+	values = append(values, rows.valuesColumns())
+
+	matchSQL, matchValues := rows.matchValues(rows.table)
+	values = append(values, matchValues...)
+
+	return "UPDATE ? SET " + sets + " FROM (VALUES " + valuesSQL + ") AS " + bulkUpdateValuesTable + " ? WHERE " + matchSQL,
+		append([]any{clause.Table{Name: rows.table}}, values...)
+}
+
+// MERGE INTO table AS cql_target USING (VALUES (...), (...)) AS cql_values (...) ON cql_target.pk = cql_values.pk
+// WHEN MATCHED THEN UPDATE SET cql_target.column = cql_values.column;
+func (rows bulkUpdateRows) merge() (string, []any) {
+	values := []any{clause.Table{Name: rows.table}}
+
+	valuesSQL, valuesValues := rows.valuesList(func(_ *schema.Field) string {
+		return "?"
+	})
+
+	values = append(values, valuesValues...)
+	values = append(values, rows.valuesColumns())
+
+	matchSQL, matchValues := rows.matchValues(bulkUpdateTargetTable)
+	values = append(values, matchValues...)
+
+	sets, setsValues := rows.setsFromValues(clause.Column{Table: bulkUpdateTargetTable})
+	values = append(values, setsValues...)
+
+	return "MERGE INTO ? AS " + bulkUpdateTargetTable +
+			" USING (VALUES " + valuesSQL + ") AS " + bulkUpdateValuesTable + " ?" +
+			" ON " + matchSQL +
+			" WHEN MATCHED THEN UPDATE SET " + sets + ";",
+		values
+}
+
+// UPDATE table SET column = CASE WHEN pk = ? THEN ? ... END WHERE pk IN (...)
+func (rows bulkUpdateRows) updateWithCase() (string, []any) {
+	values := []any{clause.Table{Name: rows.table}}
+	sets := make([]string, 0, len(rows.fields)+1)
+
+	for _, field := range rows.fields {
+		caseSQL := strings.Builder{}
+		caseSQL.WriteString("? = CASE")
+
+		values = append(values, clause.Column{Name: field.DBName})
+
+		for _, modelValue := range rows.models {
+			matchSQL, matchValues := rows.matchModel(modelValue)
+
+			caseSQL.WriteString(" WHEN " + matchSQL + " THEN ?")
+
+			values = append(values, matchValues...)
+			values = append(values, rows.valueOf(field, modelValue))
+		}
+
+		caseSQL.WriteString(" END")
+
+		sets = append(sets, caseSQL.String())
+	}
+
+	if rows.updatedAtColumn != "" {
+		sets = append(sets, "? = ?")
+		values = append(values, clause.Column{Name: rows.updatedAtColumn}, rows.now)
+	}
+
+	if rows.versionField != nil {
+		// last set, as mysql uses the updated value of the columns in the following sets
+		sets = append(sets, "? = ? + 1")
+		values = append(values, clause.Column{Name: rows.versionField.DBName}, clause.Column{Name: rows.versionField.DBName})
+	}
+
+	whereSQL, whereValues := rows.whereModels()
+	values = append(values, whereValues...)
+
+	return "UPDATE ? SET " + strings.Join(sets, ", ") + " WHERE " + whereSQL, values
+}
+
+// Returns the sets of the updated columns to the columns of the values table
+// (and of the updated at to now)
+func (rows bulkUpdateRows) setsFromValues(targetColumn clause.Column) (string, []any) {
+	sets := make([]string, 0, len(rows.fields)+1)
+	values := make([]any, 0, 2*len(rows.fields)+1)
+
+	for _, field := range rows.fields {
+		targetColumn.Name = field.DBName
+
+		sets = append(sets, "? = ?")
+		values = append(values, targetColumn, clause.Column{Table: bulkUpdateValuesTable, Name: field.DBName})
+	}
+
+	if rows.updatedAtColumn != "" {
+		targetColumn.Name = rows.updatedAtColumn
+
+		sets = append(sets, "? = ?")
+		values = append(values, targetColumn, rows.now)
+	}
+
+	if rows.versionField != nil {
+		targetColumn.Name = rows.versionField.DBName
+
+		versionColumn := clause.Column{Table: targetColumn.Table, Name: rows.versionField.DBName}
+		if versionColumn.Table == "" {
+			versionColumn.Table = rows.table
+		}
+
+		sets = append(sets, "? = ? + 1")
+		values = append(values, targetColumn, versionColumn)
+	}
+
+	return strings.Join(sets, ", "), values
+}
+
+// Returns the list of rows of the VALUES list, containing the primary keys and the updated fields of each model
+func (rows bulkUpdateRows) valuesList(placeholder func(field *schema.Field) string) (string, []any) {
+	columns := rows.valuesFields()
+
+	placeholders := make([]string, 0, len(columns))
+	for _, field := range columns {
+		placeholders = append(placeholders, placeholder(field))
+	}
+
+	rowSQL := "(" + strings.Join(placeholders, ", ") + ")"
+
+	rowsSQL := make([]string, 0, len(rows.models))
+	values := make([]any, 0, len(rows.models)*len(columns))
+
+	for _, modelValue := range rows.models {
+		rowsSQL = append(rowsSQL, rowSQL)
+
+		for _, field := range columns {
+			values = append(values, rows.valueOf(field, modelValue))
+		}
+	}
+
+	return strings.Join(rowsSQL, ", "), values
+}
+
+// Returns the columns of the values table
+func (rows bulkUpdateRows) valuesColumns() []clause.Column {
+	fields := rows.valuesFields()
+
+	columns := make([]clause.Column, 0, len(fields))
+	for _, field := range fields {
+		columns = append(columns, clause.Column{Name: field.DBName})
+	}
+
+	return columns
+}
+
+// Returns the fields that are part of the values table: the primary keys, the version and the updated fields
+func (rows bulkUpdateRows) valuesFields() []*schema.Field {
+	return append(rows.matchFields(), rows.fields...)
+}
+
+// Returns the fields that identify the row of each model: the primary keys and the version
+func (rows bulkUpdateRows) matchFields() []*schema.Field {
+	fields := make([]*schema.Field, 0, len(rows.primaryFields)+1)
+	fields = append(fields, rows.primaryFields...)
+
+	if rows.versionField != nil {
+		fields = append(fields, rows.versionField)
+	}
+
+	return fields
+}
+
+// Returns the condition that matches the rows of table with the rows of the values table
+func (rows bulkUpdateRows) matchValues(table string) (string, []any) {
+	matchFields := rows.matchFields()
+
+	matches := make([]string, 0, len(matchFields)+1)
+	values := make([]any, 0, 2*len(matchFields))
+
+	for _, matchField := range matchFields {
+		matches = append(matches, "? = ?")
+		values = append(
+			values,
+			clause.Column{Table: table, Name: matchField.DBName},
+			clause.Column{Table: bulkUpdateValuesTable, Name: matchField.DBName},
+		)
+	}
+
+	if rows.softDeleteColumn != "" {
+		matches = append(matches, "? IS NULL")
+		values = append(values, clause.Column{Table: table, Name: rows.softDeleteColumn})
+	}
+
+	return strings.Join(matches, " AND "), values
+}
+
+// Returns the condition that matches the rows of the updated models
+func (rows bulkUpdateRows) whereModels() (string, []any) {
+	var whereSQL string
+
+	values := []any{}
+
+	if len(rows.primaryFields) == 1 && rows.versionField == nil {
+		primaryKeys := make([]any, 0, len(rows.models))
+		for _, modelValue := range rows.models {
+			primaryKeys = append(primaryKeys, rows.valueOf(rows.primaryFields[0], modelValue))
+		}
+
+		whereSQL = "? IN ?"
+		values = append(values, clause.Column{Name: rows.primaryFields[0].DBName}, primaryKeys)
+	} else {
+		matches := make([]string, 0, len(rows.models))
+
+		for _, modelValue := range rows.models {
+			matchSQL, matchValues := rows.matchModel(modelValue)
+
+			matches = append(matches, "("+matchSQL+")")
+			values = append(values, matchValues...)
+		}
+
+		whereSQL = "(" + strings.Join(matches, " OR ") + ")"
+	}
+
+	if rows.softDeleteColumn != "" {
+		whereSQL += " AND ? IS NULL"
+
+		values = append(values, clause.Column{Name: rows.softDeleteColumn})
+	}
+
+	return whereSQL, values
+}
+
+// Returns the condition that matches the row of the model (and its version)
+func (rows bulkUpdateRows) matchModel(modelValue reflect.Value) (string, []any) {
+	matchFields := rows.matchFields()
+
+	matches := make([]string, 0, len(matchFields))
+	values := make([]any, 0, 2*len(matchFields))
+
+	for _, matchField := range matchFields {
+		matches = append(matches, "? = ?")
+		values = append(values, clause.Column{Name: matchField.DBName}, rows.valueOf(matchField, modelValue))
+	}
+
+	return strings.Join(matches, " AND "), values
+}
+
+func (rows bulkUpdateRows) valueOf(field *schema.Field, modelValue reflect.Value) any {
+	value, _ := field.ValueOf(rows.ctx, modelValue)
+
+	return value
+}
+
+// Returns the type of the column of the field in the database
+func columnDataType(tx *gorm.DB, field *schema.Field) string {
+	if dataTyper, isDataTyper := reflect.New(field.IndirectFieldType).Interface().(migrator.GormDataTypeInterface); isDataTyper {
+		if dataType := dataTyper.GormDBDataType(tx, field); dataType != "" {
+			return dataType
+		}
+	}
+
+	// auto increment is not part of the type of the values (for example, serial in postgres)
+	fieldCopy := *field
+	fieldCopy.AutoIncrement = false
+
+	return tx.Dialector.DataTypeOf(&fieldCopy)
+}
+
+// Create a BulkUpdate that updates the fields of each of the loaded models with its own values,
+// identifying each model by its primary key, inside transaction tx
+func NewBulkUpdate[T model.Model](tx *gorm.DB, models []*T, fields []FieldOfModel[T]) *BulkUpdate[T] {
+	var err error
+
+	for _, updatedModel := range models {
+		if updatedModel == nil || !(*updatedModel).IsLoaded() {
+			err = methodError(ErrModelNotLoaded, "BulkUpdate")
+
+			break
+		}
+	}
+
+	return &BulkUpdate[T]{
+		tx:     tx,
+		query:  NewQuery[T](tx).cqlQuery,
+		models: models,
+		fields: fields,
+		err:    err,
+	}
+}
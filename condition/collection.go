@@ -7,9 +7,9 @@ import (
 )
 
 type Collection[TObject model.Model, TAttribute model.Model] struct {
-	name    string
-	t1Field string
-	t2Field string
+	name     string
+	t1Fields []string
+	t2Fields []string
 }
 
 // Preload collection of models
@@ -24,7 +24,7 @@ func (collection Collection[TObject, TAttribute]) Any(
 	firstCondition WhereCondition[TAttribute],
 	conditions ...WhereCondition[TAttribute],
 ) WhereCondition[TObject] {
-	return newExistsCondition[TObject, TAttribute](firstCondition, conditions, collection.name, collection.t1Field, collection.t2Field)
+	return newExistsCondition[TObject, TAttribute](firstCondition, conditions, collection.name, collection.t1Fields, collection.t2Fields)
 }
 
 // None generates a condition that is true if no model in the collection fulfills the conditions
@@ -33,7 +33,7 @@ func (collection Collection[TObject, TAttribute]) None(
 	conditions ...WhereCondition[TAttribute],
 ) WhereCondition[TObject] {
 	return Not[TObject](
-		newExistsCondition[TObject, TAttribute](firstCondition, conditions, collection.name, collection.t1Field, collection.t2Field),
+		newExistsCondition[TObject, TAttribute](firstCondition, conditions, collection.name, collection.t1Fields, collection.t2Fields),
 	)
 }
 
@@ -48,15 +48,25 @@ func (collection Collection[TObject, TAttribute]) All(
 				pie.Unshift(conditions, firstCondition)...,
 			),
 			[]WhereCondition[TAttribute]{},
-			collection.name, collection.t1Field, collection.t2Field,
+			collection.name, collection.t1Fields, collection.t2Fields,
 		),
 	)
 }
 
 func NewCollection[TObject model.Model, TAttribute model.Model](name, t1Field, t2Field string) Collection[TObject, TAttribute] {
+	return NewCompositeCollection[TObject, TAttribute](name, []string{t1Field}, []string{t2Field})
+}
+
+// Collection of models related using several fields (composite foreign key).
+//
+// t1Fields[i] is matched with t2Fields[i].
+func NewCompositeCollection[TObject model.Model, TAttribute model.Model](
+	name string,
+	t1Fields, t2Fields []string,
+) Collection[TObject, TAttribute] {
 	return Collection[TObject, TAttribute]{
-		name:    name,
-		t1Field: t1Field,
-		t2Field: t2Field,
+		name:     name,
+		t1Fields: t1Fields,
+		t2Fields: t2Fields,
 	}
 }
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
-	"sync"
 	"time"
 
 	"gorm.io/gorm"
@@ -20,6 +19,7 @@ type CQLQuery struct {
 	concernedModels map[reflect.Type][]Table
 	initialTable    Table
 	selectClause    clause.Expr
+	orders          []order
 }
 
 // Order specify order when retrieving models from database.
@@ -31,36 +31,35 @@ func (query *CQLQuery) Order(field IField, descending bool) error {
 		return err
 	}
 
+	var columnName string
+
 	switch query.Dialector() {
 	case sql.Postgres:
 		// postgres supports only order by selected fields
 		query.AddSelectField(table, field, true)
-		query.gormDB = query.gormDB.Order(
-			clause.OrderByColumn{
-				Column: clause.Column{
-					Name: query.getSelectAlias(table, field),
-				},
-				Desc: descending,
-			},
-		)
-
-		return nil
+		columnName = query.getSelectAlias(table, field)
 	case sql.SQLServer, sql.SQLite, sql.MySQL:
-		query.gormDB = query.gormDB.Order(
-			clause.OrderByColumn{
-				Column: clause.Column{
-					Name: field.columnSQL(
-						query,
-						table,
-					),
-				},
-				Desc: descending,
-			},
+		columnName = field.columnSQL(
+			query,
+			table,
 		)
+	}
 
-		return nil
+	orderByColumn := clause.OrderByColumn{
+		Column: clause.Column{
+			Name: columnName,
+		},
+		Desc: descending,
 	}
 
+	query.gormDB = query.gormDB.Order(orderByColumn)
+
+	query.orders = append(query.orders, order{
+		field:  field,
+		table:  table,
+		column: orderByColumn,
+	})
+
 	return nil
 }
 
@@ -128,6 +127,34 @@ func (query *CQLQuery) Find(dest any) error {
 	return query.gormDB.Find(dest).Error
 }
 
+// Iterate executes the query and calls forEach for each row returned,
+// receiving a function that scans the row into a model.
+//
+// The iteration stops when forEach returns false
+func (query *CQLQuery) Iterate(forEach func(scan func(dest any) error) bool) error {
+	if len(query.gormDB.Statement.Preloads) > 0 {
+		return ErrCollectionPreloadsNotAllowed
+	}
+
+	rows, err := query.gormDB.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	scan := func(dest any) error {
+		return query.gormDB.ScanRows(rows, dest)
+	}
+
+	for rows.Next() {
+		if !forEach(scan) {
+			return nil
+		}
+	}
+
+	return rows.Err()
+}
+
 func (query *CQLQuery) saveInSelectClause(sql string, values []any) {
 	newSQL := query.selectClause.SQL
 	if newSQL != "" {
@@ -178,16 +205,40 @@ func (query *CQLQuery) Unscoped() {
 	query.gormDB = query.gormDB.Unscoped()
 }
 
+// OnlyDeleted limits the query to the models of the initial table that were soft deleted,
+// using softDeleteColumnName
+func (query *CQLQuery) OnlyDeleted(softDeleteColumnName string) error {
+	if softDeleteColumnName == "" {
+		return ErrNotSoftDeletable
+	}
+
+	query.Unscoped()
+	query.Where(fmt.Sprintf(
+		"%s.%s IS NOT NULL",
+		query.initialTable.SQLName(),
+		softDeleteColumnName,
+	))
+
+	return nil
+}
+
 func (query *CQLQuery) Where(whereQuery interface{}, args ...interface{}) {
 	query.gormDB = query.gormDB.Where(whereQuery, args...)
 }
 
-func (query *CQLQuery) Joins(joinQuery string, isLeftJoin bool, args ...interface{}) {
-	if isLeftJoin {
-		query.gormDB = query.gormDB.Joins("LEFT JOIN "+joinQuery, args...)
+func (query *CQLQuery) Joins(joinQuery string, joinType JoinType, args ...interface{}) error {
+	err := joinType.supportedBy(query.gormDB)
+	if err != nil {
+		return err
+	}
+
+	if joinType == InnerJoin {
+		query.gormDB = query.gormDB.InnerJoins(string(joinType)+" "+joinQuery, args...)
 	} else {
-		query.gormDB = query.gormDB.InnerJoins("INNER JOIN "+joinQuery, args...)
+		query.gormDB = query.gormDB.Joins(string(joinType)+" "+joinQuery, args...)
 	}
+
+	return nil
 }
 
 func (query *CQLQuery) AddConcernedModel(model model.Model, table Table) {
@@ -256,12 +307,24 @@ func NewGormQuery(db *gorm.DB, initialModel model.Model, initialTable Table) *CQ
 // returns error is table name can not be found by gorm,
 // probably because the type of "entity" is not registered using AddModel
 func getTableName(db *gorm.DB, entity any) (string, error) {
-	schemaName, err := schema.Parse(entity, &sync.Map{}, db.NamingStrategy)
+	entitySchema, err := getSchema(db, entity)
 	if err != nil {
 		return "", err
 	}
 
-	return schemaName.Table, nil
+	return entitySchema.Table, nil
+}
+
+// Get the schema of "entity" parsed by gorm, using the cache of db
+func getSchema(db *gorm.DB, entity any) (*schema.Schema, error) {
+	statement := db.Session(&gorm.Session{NewDB: true}).Statement
+
+	err := statement.Parse(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return statement.Schema, nil
 }
 
 // available for: postgres, sqlite, sqlserver
@@ -301,8 +364,12 @@ func (query *CQLQuery) cleanSelects() {
 	query.gormDB.Statement.Selects = []string{}
 }
 
-// Find finds all models matching given conditions
+// Update updates the models matching given conditions with the values of the sets
 func (query *CQLQuery) Update(sets []ISet) (int64, error) {
+	return rowsAffected(query.update(sets))
+}
+
+func (query *CQLQuery) update(sets []ISet) (*gorm.DB, error) {
 	updateMap := map[string]any{}
 
 	query.cleanSelects()
@@ -314,17 +381,23 @@ func (query *CQLQuery) Update(sets []ISet) (int64, error) {
 
 			updateValue, err := getUpdateValue(query, set)
 			if err != nil {
-				return 0, err
+				return nil, err
 			}
 
 			table, err := query.GetModelTable(field)
 			if err != nil {
-				return 0, err
+				return nil, err
 			}
 
 			updateMap[field.columnName(query, table)] = updateValue
 		}
 
+		if len(sets) > 0 {
+			if versionColumn := versionColumnName(sets[0].getModel()); versionColumn != "" {
+				updateMap[versionColumn] = versionIncrement(query.initialTable, versionColumn)
+			}
+		}
+
 		query.joinsToFrom()
 	case sql.MySQL: // support UPDATE JOIN SET
 		// if at least one join is done,
@@ -341,12 +414,12 @@ func (query *CQLQuery) Update(sets []ISet) (int64, error) {
 
 			updateValue, err := getUpdateValue(query, set)
 			if err != nil {
-				return 0, err
+				return nil, err
 			}
 
 			table, err := query.GetModelTable(field)
 			if err != nil {
-				return 0, err
+				return nil, err
 			}
 
 			setClause = append(setClause, clause.Assignment{
@@ -374,14 +447,27 @@ func (query *CQLQuery) Update(sets []ISet) (int64, error) {
 					Value: now,
 				})
 			}
+
+			if versionColumn := versionColumnName(model); versionColumn != "" {
+				setClause = append(setClause, clause.Assignment{
+					Column: clause.Column{
+						Name:  versionColumn,
+						Table: table.SQLName(),
+					},
+					Value: versionIncrement(table, versionColumn),
+				})
+			}
 		}
 
 		query.gormDB.Clauses(setClause)
 	}
 
-	update := query.gormDB.Updates(updateMap)
+	return query.gormDB.Updates(updateMap), nil
+}
 
-	return update.RowsAffected, update.Error
+// Returns the expression that increments the version of the models in table
+func versionIncrement(table Table, versionColumn string) clause.Expr {
+	return gorm.Expr(table.SQLName() + "." + versionColumn + " + 1")
 }
 
 func (query *CQLQuery) joinsToFrom() {
@@ -429,9 +515,10 @@ func getUpdateValue(query *CQLQuery, set ISet) (any, error) {
 
 // Splits a JOIN statement into the table name, table alias and ON statement
 func splitJoin(joinStatement string) (string, string, string) {
-	// remove INNER JOIN and LEFT JOIN
-	joinStatement = strings.ReplaceAll(joinStatement, "INNER JOIN ", "")
-	joinStatement = strings.ReplaceAll(joinStatement, "LEFT JOIN ", "")
+	// remove the type of join
+	for _, joinType := range joinTypes {
+		joinStatement = strings.ReplaceAll(joinStatement, string(joinType)+" ", "")
+	}
 
 	// divide table and on statement
 	joinStatementSplit := strings.Split(joinStatement, " ON ")
@@ -445,6 +532,10 @@ func splitJoin(joinStatement string) (string, string, string) {
 }
 
 func (query *CQLQuery) SoftDelete(softDeleteColumnName string) (int64, error) {
+	return rowsAffected(query.softDelete(softDeleteColumnName), nil)
+}
+
+func (query *CQLQuery) softDelete(softDeleteColumnName string) *gorm.DB {
 	switch query.Dialector() {
 	case sql.Postgres, sql.SQLServer, sql.SQLite: // support UPDATE SET FROM
 		query.joinsToFrom()
@@ -466,26 +557,33 @@ func (query *CQLQuery) SoftDelete(softDeleteColumnName string) (int64, error) {
 
 	query.gormDB.Statement.Selects = []string{}
 
-	deleteTx := query.gormDB.Delete(query.gormDB.Statement.Model)
-
-	return deleteTx.RowsAffected, deleteTx.Error
+	return query.gormDB.Delete(query.gormDB.Statement.Model)
 }
 
+// name of the derived table that contains the primary keys of the models to be deleted
+const deletedSubQueryTable = "cql_deleted"
+
 func (query *CQLQuery) Delete(cqlSubQuery *CQLQuery) (int64, error) {
+	return rowsAffected(query.delete(cqlSubQuery))
+}
+
+func (query *CQLQuery) delete(cqlSubQuery *CQLQuery) (*gorm.DB, error) {
 	var deleteTx *gorm.DB
 
 	if len(cqlSubQuery.gormDB.Statement.Joins) > 0 {
 		switch query.Dialector() {
 		case sql.Postgres, sql.SQLServer, sql.SQLite: // support DELETE SELECT
 			// there are joins, we must use delete from subquery as gorm does not support delete join
+			primaryKeySQL, primaryKeyValues, err := query.primaryKeyInSubQuery(cqlSubQuery)
+			if err != nil {
+				return nil, err
+			}
+
 			deleteTx = query.gormDB.
-				Where(
-					"id IN (?)",
-					cqlSubQuery.gormDB.Select(cqlSubQuery.initialTable.Name+".id"),
-				).
+				Where(primaryKeySQL, primaryKeyValues...).
 				Delete(query.gormDB.Statement.Model)
 		case sql.MySQL:
-			return 0,
+			return nil,
 				methodError(
 					joinsInDeleteNotAllowed(sql.MySQL),
 					"Delete",
@@ -497,5 +595,55 @@ func (query *CQLQuery) Delete(cqlSubQuery *CQLQuery) (int64, error) {
 		deleteTx = query.gormDB.Delete(query.gormDB.Statement.Model)
 	}
 
-	return deleteTx.RowsAffected, deleteTx.Error
+	return deleteTx, nil
+}
+
+// Returns the condition that verifies that the primary key of the model of the query
+// is in the results of cqlSubQuery:
+//
+// pk IN (SELECT table.pk ...)
+//
+// or, for composite primary keys, as sqlserver does not support row values,
+// EXISTS (SELECT 1 FROM (SELECT table.pk1, table.pk2 ...) AS cql_deleted WHERE cql_deleted.pk1 = table.pk1 AND ...)
+func (query *CQLQuery) primaryKeyInSubQuery(cqlSubQuery *CQLQuery) (string, []any, error) {
+	modelSchema, err := getSchema(query.gormDB, query.gormDB.Statement.Model)
+	if err != nil {
+		return "", nil, err
+	}
+
+	primaryKeys := make([]string, 0, len(modelSchema.PrimaryFieldDBNames))
+	for _, primaryKey := range modelSchema.PrimaryFieldDBNames {
+		primaryKeys = append(primaryKeys, cqlSubQuery.initialTable.Name+"."+primaryKey)
+	}
+
+	subQuery := cqlSubQuery.gormDB.Select(primaryKeys)
+
+	if len(modelSchema.PrimaryFieldDBNames) == 1 {
+		return "? IN (?)", []any{clause.Column{Name: modelSchema.PrimaryFieldDBNames[0]}, subQuery}, nil
+	}
+
+	matches := make([]string, 0, len(modelSchema.PrimaryFieldDBNames))
+	values := []any{subQuery}
+
+	for _, primaryKey := range modelSchema.PrimaryFieldDBNames {
+		matches = append(matches, "? = ?")
+		values = append(
+			values,
+			clause.Column{Table: deletedSubQueryTable, Name: primaryKey},
+			clause.Column{Table: query.initialTable.Name, Name: primaryKey},
+		)
+	}
+
+	return "EXISTS (SELECT 1 FROM (?) AS " + deletedSubQueryTable + " WHERE " + strings.Join(matches, " AND ") + ")",
+		values,
+		nil
+}
+
+// Returns the amount of rows affected by the execution of tx
+func rowsAffected(tx *gorm.DB, err error) (int64, error) {
+	if err != nil {
+		return 0, err
+	}
+
+	return tx.RowsAffected, tx.Error
 }
@@ -0,0 +1,177 @@
+package condition
+
+import (
+	"fmt"
+	"regexp"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// Field of the model TModel whose value is of type TAttribute
+type FieldOfType[TModel model.Model, TAttribute any] interface {
+	FieldOfModel[TModel]
+	ValueOfType[TAttribute]
+}
+
+// the name of a common table expression is written in the sql as is,
+// so only valid identifiers are accepted
+var cteNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Common table expression (WITH) whose rows are models of type T,
+// so it can be queried using the conditions of T
+type CTE[T model.Model] struct {
+	name      string
+	query     *Query[T]
+	recursive *Query[T]
+	err       error
+}
+
+// Creates a common table expression with the models obtained by query.
+//
+// Only a Query can be used (and not a SelectQuery) as the rows of the common table expression
+// must be models of type T to be queried using its conditions.
+//
+// name must be a valid identifier (letters, digits and underscores, not starting with a digit),
+// otherwise ErrInvalidCTEName is returned
+func NewCTE[T model.Model](name string, query *Query[T]) CTE[T] {
+	cte := CTE[T]{
+		name:  name,
+		query: query,
+		err:   query.err,
+	}
+
+	if cte.err == nil && !cteNameRegex.MatchString(name) {
+		cte.err = invalidCTENameError(name)
+	}
+
+	return cte
+}
+
+// Creates a recursive common table expression.
+//
+// The models obtained by anchor are the initial rows of the common table expression,
+// then, the models whose field is equal to the previousField of a model already in the
+// common table expression (and fulfill the conditions) are added, until no more models are found.
+func NewRecursiveCTE[T model.Model, TAttribute any](
+	name string,
+	anchor *Query[T],
+	field, previousField FieldOfType[T, TAttribute],
+	conditions ...Condition[T],
+) CTE[T] {
+	cte := NewCTE(name, anchor)
+	if cte.err != nil {
+		return cte
+	}
+
+	cte.recursive = NewQuery(anchor.cqlQuery.gormDB.Session(&gorm.Session{NewDB: true}), conditions...)
+	if cte.recursive.err != nil {
+		cte.err = cte.recursive.err
+
+		return cte
+	}
+
+	recursiveQuery := cte.recursive.cqlQuery
+	initialTable := recursiveQuery.initialTable
+
+	fieldSQL, fieldValues, err := field.ToSQLForTable(recursiveQuery, initialTable)
+	if err != nil {
+		cte.err = err
+
+		return cte
+	}
+
+	previousFieldSQL, previousFieldValues, err := previousField.ToSQLForTable(
+		recursiveQuery,
+		Table{
+			Name:  initialTable.Name,
+			Alias: name,
+		},
+	)
+	if err != nil {
+		cte.err = err
+
+		return cte
+	}
+
+	err = recursiveQuery.Joins(
+		fmt.Sprintf("%s ON %s = %s", name, fieldSQL, previousFieldSQL),
+		InnerJoin,
+		append(fieldValues, previousFieldValues...)...,
+	)
+	if err != nil {
+		cte.err = err
+	}
+
+	return cte
+}
+
+func (cte CTE[T]) withClause() withClause {
+	with := withClause{
+		name:  cte.name,
+		query: cte.query.cqlQuery.gormDB,
+	}
+
+	if cte.recursive != nil {
+		with.recursive = cte.recursive.cqlQuery.gormDB
+	}
+
+	return with
+}
+
+// Creates a Query that obtains the models from the common table expression
+// instead of from the table of the model
+func NewQueryFrom[T model.Model](tx *gorm.DB, cte CTE[T], conditions ...Condition[T]) *Query[T] {
+	if cte.err != nil {
+		return &Query[T]{err: cte.err}
+	}
+
+	tableName, err := getTableName(tx, *new(T))
+	if err != nil {
+		return &Query[T]{err: err}
+	}
+
+	// the common table expression is named as the table of the model
+	// so conditions are applied to it
+	return NewQuery(
+		tx.Table(cte.name+" "+tableName).Clauses(cte.withClause()),
+		conditions...,
+	)
+}
+
+// WITH [RECURSIVE] name AS (query [UNION ALL recursive])
+type withClause struct {
+	name      string
+	query     *gorm.DB
+	recursive *gorm.DB
+}
+
+func (with withClause) Build(builder clause.Builder) {
+	builder.WriteString("WITH ")
+
+	// sqlserver does not use the RECURSIVE keyword
+	if with.recursive != nil && sql.Dialector(with.query.Dialector.Name()) != sql.SQLServer {
+		builder.WriteString("RECURSIVE ")
+	}
+
+	builder.WriteString(with.name)
+	builder.WriteString(" AS (")
+	builder.AddVar(builder, with.query)
+
+	if with.recursive != nil {
+		builder.WriteString(" UNION ALL ")
+		builder.AddVar(builder, with.recursive)
+	}
+
+	builder.WriteByte(')')
+}
+
+// The WITH is added before the SELECT clause
+func (with withClause) ModifyStatement(stmt *gorm.Statement) {
+	selectClause := stmt.Clauses["SELECT"]
+	selectClause.BeforeExpression = with
+	stmt.Clauses["SELECT"] = selectClause
+}
@@ -0,0 +1,89 @@
+package condition
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// versions of sqlite obtained for each database, so the query is executed only once
+var sqliteVersions sync.Map
+
+// versions of the postgres server obtained for each database, so the query is executed only once
+var postgresVersions sync.Map
+
+// Returns true if the version of the mysql server is known to be less than minVersion.
+//
+// MariaDB versions are not compared as they use a different numbering.
+func mysqlVersionLessThan(db *gorm.DB, minVersion []int) bool {
+	mysqlDialector, isMySQL := db.Dialector.(*mysql.Dialector)
+	if !isMySQL || mysqlDialector.Config == nil || mysqlDialector.ServerVersion == "" ||
+		strings.Contains(mysqlDialector.ServerVersion, "MariaDB") {
+		return false
+	}
+
+	return versionLessThan(mysqlDialector.ServerVersion, minVersion)
+}
+
+// Returns true if the version of the sqlite library used by db is less than minVersion
+func sqliteVersionLessThan(db *gorm.DB, minVersion []int) (bool, error) {
+	version, isPresent := sqliteVersions.Load(db.Config)
+	if !isPresent {
+		var sqliteVersion string
+
+		err := db.Session(&gorm.Session{NewDB: true}).Raw("SELECT sqlite_version()").Scan(&sqliteVersion).Error
+		if err != nil {
+			return false, err
+		}
+
+		version, _ = sqliteVersions.LoadOrStore(db.Config, sqliteVersion)
+	}
+
+	return versionLessThan(version.(string), minVersion), nil //nolint:forcetypeassert // only strings are stored
+}
+
+// Returns true if the version of the postgres server used by db is less than minVersion
+func postgresVersionLessThan(db *gorm.DB, minVersion []int) (bool, error) {
+	version, isPresent := postgresVersions.Load(db.Config)
+	if !isPresent {
+		var postgresVersion string
+
+		err := db.Session(&gorm.Session{NewDB: true}).Raw("SHOW server_version").Scan(&postgresVersion).Error
+		if err != nil {
+			return false, err
+		}
+
+		// server_version can contain information after the version number, for example "16.2 (Debian 16.2-1.pgdg120+2)"
+		postgresVersion, _, _ = strings.Cut(postgresVersion, " ")
+
+		version, _ = postgresVersions.LoadOrStore(db.Config, postgresVersion)
+	}
+
+	return versionLessThan(version.(string), minVersion), nil //nolint:forcetypeassert // only strings are stored
+}
+
+// Returns true if version (major.minor.patch[-suffix]) is less than minVersion
+func versionLessThan(version string, minVersion []int) bool {
+	versionNumber, _, _ := strings.Cut(version, "-")
+	versionNumbers := strings.Split(versionNumber, ".")
+
+	for i, minNumber := range minVersion {
+		if i >= len(versionNumbers) {
+			return true
+		}
+
+		number, err := strconv.Atoi(versionNumbers[i])
+		if err != nil {
+			return false
+		}
+
+		if number != minNumber {
+			return number < minNumber
+		}
+	}
+
+	return false
+}
@@ -11,6 +11,45 @@ type Delete[T model.Model] struct {
 
 	secondaryQuery       *Query[T]
 	softDeleteColumnName string
+	// model to be deleted when created with NewModelDelete
+	loaded *loadedModel[T]
+
+	// used to recreate the delete when HardDelete is called
+	tx         *gorm.DB
+	conditions []Condition[T]
+	// order, limit and returning applied, replayed when HardDelete is called
+	applied []func(deleteS *Delete[T])
+}
+
+// HardDelete makes the models to be deleted from the database
+// even if they support soft delete (their SoftDeleteColumnName is not empty),
+// instead of only setting their deleted at column.
+// Models that were already soft deleted and match the conditions are also deleted.
+//
+// The order, limit and returning already applied to the Delete are kept.
+func (deleteS *Delete[T]) HardDelete() *Delete[T] {
+	if deleteS.softDeleteColumnName == "" {
+		return deleteS
+	}
+
+	hardDelete := newDelete(deleteS.tx, deleteS.conditions, "")
+	hardDelete.loaded = deleteS.loaded
+
+	hardDelete.query.addError(deleteS.query.err)
+	hardDelete.query.addError(hardDelete.secondaryQuery.err)
+
+	if hardDelete.query.err == nil {
+		hardDelete.query.cqlQuery.Unscoped()
+		hardDelete.secondaryQuery.cqlQuery.Unscoped()
+
+		for _, apply := range deleteS.applied {
+			apply(hardDelete)
+		}
+	}
+
+	*deleteS = *hardDelete
+
+	return deleteS
 }
 
 // Ascending specify an ascending order when updating models
@@ -18,6 +57,9 @@ type Delete[T model.Model] struct {
 // available for: mysql
 func (deleteS *Delete[T]) Ascending(field IField) *Delete[T] {
 	deleteS.OrderLimitReturning.Ascending(field)
+	deleteS.applied = append(deleteS.applied, func(deleteS *Delete[T]) {
+		deleteS.OrderLimitReturning.Ascending(field)
+	})
 
 	return deleteS
 }
@@ -27,6 +69,9 @@ func (deleteS *Delete[T]) Ascending(field IField) *Delete[T] {
 // available for: mysql
 func (deleteS *Delete[T]) Descending(field IField) *Delete[T] {
 	deleteS.OrderLimitReturning.Descending(field)
+	deleteS.applied = append(deleteS.applied, func(deleteS *Delete[T]) {
+		deleteS.OrderLimitReturning.Descending(field)
+	})
 
 	return deleteS
 }
@@ -38,6 +83,9 @@ func (deleteS *Delete[T]) Descending(field IField) *Delete[T] {
 // available for: mysql
 func (deleteS *Delete[T]) Limit(limit int) *Delete[T] {
 	deleteS.OrderLimitReturning.Limit(limit)
+	deleteS.applied = append(deleteS.applied, func(deleteS *Delete[T]) {
+		deleteS.OrderLimitReturning.Limit(limit)
+	})
 
 	return deleteS
 }
@@ -65,6 +113,9 @@ func (deleteS *Delete[T]) Returning(dest *[]T) *Delete[T] {
 	}
 
 	deleteS.OrderLimitReturning.Returning(dest)
+	deleteS.applied = append(deleteS.applied, func(deleteS *Delete[T]) {
+		deleteS.OrderLimitReturning.Returning(dest)
+	})
 
 	return deleteS
 }
@@ -74,29 +125,65 @@ func (deleteS *Delete[T]) Exec() (int64, error) {
 		return 0, deleteS.query.err
 	}
 
+	var deleted int64
+
+	var err error
+
 	if deleteS.softDeleteColumnName != "" {
-		return deleteS.query.cqlQuery.SoftDelete(deleteS.softDeleteColumnName)
+		deleted, err = deleteS.query.cqlQuery.SoftDelete(deleteS.softDeleteColumnName)
+	} else {
+		deleted, err = deleteS.query.cqlQuery.Delete(
+			deleteS.secondaryQuery.cqlQuery,
+		)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if deleteS.loaded != nil {
+		err = deleteS.loaded.verifyAffected(deleted)
+		if err != nil {
+			return 0, methodError(err, "Exec")
+		}
 	}
 
-	return deleteS.query.cqlQuery.Delete(
-		deleteS.secondaryQuery.cqlQuery,
-	)
+	return deleted, nil
+}
+
+// ToSQL returns the sql and values of the statement that Exec would execute, without executing it
+func (deleteS *Delete[T]) ToSQL() (string, []any, error) {
+	if deleteS.query.err != nil {
+		return "", nil, deleteS.query.err
+	}
+
+	cqlQuery := deleteS.query.cqlQuery
+
+	return cqlQuery.ToSQL(func() (*gorm.DB, error) {
+		if deleteS.softDeleteColumnName != "" {
+			return cqlQuery.softDelete(deleteS.softDeleteColumnName), nil
+		}
+
+		return cqlQuery.delete(deleteS.secondaryQuery.cqlQuery)
+	})
 }
 
 // Create a Delete to which the conditions are applied inside transaction tx
 func NewDelete[T model.Model](tx *gorm.DB, conditions []Condition[T]) *Delete[T] {
+	return newDelete(tx, conditions, (*new(T)).SoftDeleteColumnName())
+}
+
+// Create a Delete to which the conditions are applied inside transaction tx,
+// that uses softDeleteColumnName to soft delete the models (if not empty)
+func newDelete[T model.Model](tx *gorm.DB, conditions []Condition[T], softDeleteColumnName string) *Delete[T] {
 	var err error
 
 	if len(conditions) == 0 {
 		err = methodError(ErrEmptyConditions, "Delete")
 	}
 
-	model := *new(T)
-
 	var primaryQuery, secondaryQuery *Query[T]
 
-	softDeleteColumnName := model.SoftDeleteColumnName()
-
 	if softDeleteColumnName != "" {
 		// as soft delete is implemented with UPDATE, conditions can be applied directly to primary query
 		primaryQuery = NewQuery(tx, conditions...)
@@ -117,5 +204,26 @@ func NewDelete[T model.Model](tx *gorm.DB, conditions []Condition[T]) *Delete[T]
 		},
 		secondaryQuery:       secondaryQuery,
 		softDeleteColumnName: softDeleteColumnName,
+		tx:                   tx,
+		conditions:           conditions,
 	}
 }
+
+// Create a Delete of the model loaded, identified by its primary key, inside transaction tx
+//
+// If the model uses optimistic locking (embeds model.Versioned),
+// its version is also verified, so ErrStaleObject is returned if it was modified since it was loaded
+func NewModelDelete[T model.Model](tx *gorm.DB, loaded *T) *Delete[T] {
+	loadedModel, conditions, err := newLoadedModel(tx, loaded)
+	if err != nil {
+		deleteS := NewDelete[T](tx, nil)
+		deleteS.query.err = methodError(err, "DeleteModel")
+
+		return deleteS
+	}
+
+	deleteS := NewDelete(tx, conditions)
+	deleteS.loaded = loadedModel
+
+	return deleteS
+}
@@ -3,6 +3,7 @@ package condition
 import (
 	"errors"
 	"fmt"
+	"reflect"
 
 	"github.com/FrancoLiberali/cql/model"
 	"github.com/FrancoLiberali/cql/sql"
@@ -14,24 +15,63 @@ var (
 	ErrFieldModelNotConcerned   = errors.New("field's model is not concerned by the query (not joined)")
 	ErrAppearanceMustBeSelected = errors.New("field's model appears more than once, select which one you want to use with Appearance")
 	ErrAppearanceOutOfRange     = errors.New("selected appearance is bigger than field's model number of appearances")
+	ErrSelectionOutOfRange      = errors.New("selection index is bigger than the number of selections")
+	ErrInvalidCTEName           = errors.New("common table expression name must be a valid identifier (letters, digits and underscores, not starting with a digit)")
+
+	// set operations
+
+	ErrSetOperationOrderOrLimit   = errors.New("order, limit and offset can not be applied to the queries combined by a set operation")
+	ErrSetOperationColumnMismatch = errors.New("queries combined by a set operation must select the same number of values")
 
 	// conditions
 
 	ErrEmptyConditions = errors.New("at least one condition is required")
+	ErrEmptySets       = errors.New("at least one set is required")
 
 	// crud
 
 	ErrMoreThanOneObjectFound = errors.New("found more that one object that meet the requested conditions")
 	ErrObjectNotFound         = errors.New("no object exists that meets the requested conditions")
+	ErrModelNotLoaded         = errors.New("model is not loaded from the database (its primary key is empty)")
+	ErrStaleObject            = errors.New("object was modified or deleted by other transaction since it was loaded")
+	ErrNotSoftDeletable       = errors.New("model does not support soft delete (its SoftDeleteColumnName is empty)")
+	ErrInvalidBatchSize       = errors.New("batch size must be greater than zero")
+	ErrPrimaryKeyNotGenerated = errors.New("primary key is not generated by the database (auto increment or default value) and is not set by any of the sets")
 
 	// database
 
 	ErrUnsupportedByDatabase = errors.New("method not supported by database")
 	ErrOrderByMustBeCalled   = errors.New("order by must be called before limit in an update statement")
 
+	// constraints
+
+	ErrUniqueViolation     = errors.New("unique constraint violated")
+	ErrForeignKeyViolation = errors.New("foreign key constraint violated")
+	ErrNotNullViolation    = errors.New("not null constraint violated")
+	ErrCheckViolation      = errors.New("check constraint violated")
+
+	// merge
+
+	ErrMergeWithoutActions = errors.New("at least one action is required (WhenMatchedUpdate, WhenMatchedDelete or WhenNotMatchedInsert)")
+	ErrFieldNotSet         = errors.New("field is not set by any of the sets")
+
+	// transactions
+
+	ErrNotInTransaction         = errors.New("method can only be used inside a transaction")
+	ErrInvalidTransactionOption = errors.New("transaction option must be a *sql.TxOptions or the result of WithRetry")
+
+	// keyset pagination
+
+	ErrKeysetOrderNotAllowed  = errors.New("only fields of the queried model without functions can be used to order a keyset pagination")
+	ErrKeysetOffsetNotAllowed = errors.New("offset can not be used in a keyset pagination")
+	ErrInvalidPageToken       = errors.New("page token is invalid")
+	ErrPageTokenOrderMismatch = errors.New("page token was produced for a different order")
+
 	// preload
 
-	ErrOnlyPreloadsAllowed = errors.New("only conditions that do a preload are allowed")
+	ErrOnlyPreloadsAllowed          = errors.New("only conditions that do a preload are allowed")
+	ErrCollectionPreloadsNotAllowed = errors.New("collection preloads are not allowed when iterating")
+	ErrJoinOnPreloadNotAllowed      = errors.New("preloads are not allowed in models joined without a relation")
 
 	ErrPreloadsInDeleteReturningNotAllowed = errors.New("preloads in delete returning are not allowed")
 )
@@ -40,6 +80,14 @@ func methodError(err error, method string) error {
 	return fmt.Errorf("%w; method: %s", err, method)
 }
 
+func joinTypeError(err error, joinType JoinType) error {
+	return fmt.Errorf("%w; join: %s", err, joinType)
+}
+
+func setOperationError(err error, operation setOperation) error {
+	return fmt.Errorf("%w; set operation: %s", err, operation.name)
+}
+
 func fieldModelNotConcernedError(field IField) error {
 	return fmt.Errorf("%w; not concerned model: %s",
 		ErrFieldModelNotConcerned,
@@ -47,6 +95,13 @@ func fieldModelNotConcernedError(field IField) error {
 	)
 }
 
+func lockOfModelNotConcernedError(modelType reflect.Type) error {
+	return fmt.Errorf("%w; not concerned model: %s; method: LockOf",
+		ErrFieldModelNotConcerned,
+		modelType,
+	)
+}
+
 func fieldModelError(err error, field IField) error {
 	return fmt.Errorf("%w; model: %s", err, field.getModelType())
 }
@@ -59,6 +114,10 @@ func appearanceOutOfRangeError(field IField) error {
 	return fieldModelError(ErrAppearanceOutOfRange, field)
 }
 
+func joinOnPreloadNotAllowedError[T model.Model]() error {
+	return fmt.Errorf("%w; model: %T", ErrJoinOnPreloadNotAllowed, *new(T))
+}
+
 func preloadsInReturningNotAllowed(dialector sql.Dialector) error {
 	return fmt.Errorf("%w; preloads in returning are not allowed for database: %s",
 		ErrUnsupportedByDatabase,
@@ -73,6 +132,30 @@ func joinsInDeleteNotAllowed(dialector sql.Dialector) error {
 	)
 }
 
+func fieldNotSetError(field IField) error {
+	return fmt.Errorf("%w; field: %s", ErrFieldNotSet, field.fieldName())
+}
+
+func primaryKeyNotGeneratedError(fieldName string) error {
+	return fmt.Errorf("%w; field: %s", ErrPrimaryKeyNotGenerated, fieldName)
+}
+
+func keysetOrderNotAllowedError(field IField) error {
+	return fmt.Errorf("%w; model: %s, field: %s",
+		ErrKeysetOrderNotAllowed,
+		field.getModelType(),
+		field.fieldName(),
+	)
+}
+
+func invalidCTENameError(name string) error {
+	return fmt.Errorf("%w; name: %s", ErrInvalidCTEName, name)
+}
+
+func invalidPageTokenError(err error) error {
+	return fmt.Errorf("%w; %s", ErrInvalidPageToken, err.Error())
+}
+
 func conditionOperatorError[TObject model.Model, TAtribute any](operatorErr error, condition fieldCondition[TObject, TAtribute]) error {
 	return fmt.Errorf(
 		"%w; model: %T, field: %s",
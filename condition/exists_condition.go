@@ -12,20 +12,21 @@ import (
 type existsCondition[T1 model.Model, T2 model.Model] struct {
 	Conditions    []WhereCondition[T2]
 	RelationField string
-	T1Field       string
-	T2Field       string
+	T1Fields      []string
+	T2Fields      []string
 }
 
 func newExistsCondition[T1 model.Model, T2 model.Model](
 	firstCondition WhereCondition[T2],
 	conditions []WhereCondition[T2],
-	relationField, t1Field, t2Field string,
+	relationField string,
+	t1Fields, t2Fields []string,
 ) existsCondition[T1, T2] {
 	return existsCondition[T1, T2]{
 		Conditions:    pie.Unshift(conditions, firstCondition),
 		RelationField: relationField,
-		T1Field:       t1Field,
-		T2Field:       t2Field,
+		T1Fields:      t1Fields,
+		T2Fields:      t2Fields,
 	}
 }
 
@@ -66,7 +67,7 @@ func (condition existsCondition[T1, T2]) getSQL(query *CQLQuery, t1Table Table)
 		"EXISTS (SELECT(1) FROM %s %s WHERE %s AND %s %s)",
 		t2Table.Name,
 		t2Table.Alias,
-		getSQLJoin(query, t1Table, condition.T1Field, t2Table, condition.T2Field),
+		getSQLJoin(query, t1Table, condition.T1Fields, t2Table, condition.T2Fields),
 		sql,
 		deletedAtSQL,
 	), values, nil
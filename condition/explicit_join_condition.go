@@ -0,0 +1,177 @@
+package condition
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/elliotchance/pie/v2"
+	"gorm.io/gorm/clause"
+
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Condition that joins T1 with T2 comparing a field of each one,
+// without the need of a relation between them
+func NewExplicitJoinCondition[T1, T2 model.Model, TAttribute any](
+	t1Field FieldOfType[T1, TAttribute],
+	t2Field FieldOfType[T2, TAttribute],
+	joinType JoinType,
+	conditions []Condition[T2],
+) JoinCondition[T1] {
+	return explicitJoinCondition[T1, T2]{
+		T1Field:    t1Field,
+		T2Field:    t2Field,
+		JoinType:   joinType,
+		Conditions: conditions,
+	}
+}
+
+// Implementation of a join condition that is not based on a relation
+type explicitJoinCondition[T1, T2 model.Model] struct {
+	T1Field    IField
+	T2Field    IField
+	JoinType   JoinType
+	Conditions []Condition[T2]
+	T2Preload  bool
+}
+
+// Preload is not allowed as T2 is not related to T1,
+// so ErrJoinOnPreloadNotAllowed will be returned when the query is executed.
+func (condition explicitJoinCondition[T1, T2]) Preload() JoinCondition[T1] {
+	condition.T2Preload = true
+
+	return condition
+}
+
+func (condition explicitJoinCondition[T1, T2]) InnerJoin() JoinCondition[T1] {
+	condition.JoinType = InnerJoin
+
+	return condition
+}
+
+func (condition explicitJoinCondition[T1, T2]) LeftJoin() JoinCondition[T1] {
+	condition.JoinType = LeftJoin
+
+	return condition
+}
+
+func (condition explicitJoinCondition[T1, T2]) RightJoin() JoinCondition[T1] {
+	condition.JoinType = RightJoin
+
+	return condition
+}
+
+func (condition explicitJoinCondition[T1, T2]) FullJoin() JoinCondition[T1] {
+	condition.JoinType = FullJoin
+
+	return condition
+}
+
+func (condition explicitJoinCondition[T1, T2]) interfaceVerificationMethod(_ T1) {
+	// This method is necessary to get the compiler to verify
+	// that an object is of type Condition[T]
+}
+
+// Returns true if this condition or any nested condition makes a preload
+func (condition explicitJoinCondition[T1, T2]) makesPreload() bool {
+	_, joinConditions := divideConditionsByType(condition.Conditions)
+
+	return condition.T2Preload || pie.Any(joinConditions, func(cond JoinCondition[T2]) bool {
+		return cond.makesPreload()
+	})
+}
+
+// Returns true if the condition of nay nested condition applies a filter (has where conditions)
+func (condition explicitJoinCondition[T1, T2]) makesFilter() bool {
+	whereConditions, joinConditions := divideConditionsByType(condition.Conditions)
+
+	return condition.JoinType != LeftJoin || len(whereConditions) != 0 || pie.Any(joinConditions, func(cond JoinCondition[T2]) bool {
+		return cond.makesFilter()
+	})
+}
+
+// Applies a join between the tables of T1 and T2
+// where the value of T1Field is equal to the value of T2Field
+// It also applies the nested conditions
+func (condition explicitJoinCondition[T1, T2]) applyTo(query *CQLQuery, t1Table Table) error {
+	if condition.makesPreload() {
+		return joinOnPreloadNotAllowedError[T2]()
+	}
+
+	whereConditions, joinConditions := divideConditionsByType(condition.Conditions)
+
+	t2Model := *new(T2)
+
+	// each join with T2 gets its own alias,
+	// so T2 can be joined more than once (selected using Appearance)
+	relationName := "JoinOn" + reflect.TypeOf(t2Model).Name()
+	if joinedTimes := len(query.GetTables(reflect.TypeOf(t2Model))); joinedTimes > 0 {
+		relationName += strconv.Itoa(joinedTimes)
+	}
+
+	t2Table, err := t1Table.DeliverTable(query, t2Model, relationName)
+	if err != nil {
+		return err
+	}
+
+	t1FieldSQL, t1FieldValues, err := condition.T1Field.ToSQLForTable(query, t1Table)
+	if err != nil {
+		return err
+	}
+
+	t2FieldSQL, t2FieldValues, err := condition.T2Field.ToSQLForTable(query, t2Table)
+	if err != nil {
+		return err
+	}
+
+	query.AddConcernedModel(
+		t2Model,
+		t2Table,
+	)
+
+	joinQuery := fmt.Sprintf(
+		"%s %s ON %s = %s",
+		t2Table.Name,
+		t2Table.Alias,
+		t2FieldSQL,
+		t1FieldSQL,
+	)
+	joinValues := append(t2FieldValues, t1FieldValues...)
+
+	// apply WhereConditions to the join in the "on" clause
+	// (or in the "where" for joins that preserve the rows of T2)
+	conditionsQuery, conditionsValues, err := joinedModelConditionsSQL(query, t2Table, whereConditions)
+	if err != nil {
+		return err
+	}
+
+	if conditionsQuery != "" {
+		if condition.JoinType.preservesJoinedModel() {
+			query.Where(conditionsQuery, conditionsValues...)
+		} else {
+			joinQuery += clause.AndWithSpace + conditionsQuery
+			joinValues = append(joinValues, conditionsValues...)
+		}
+	}
+
+	// add the join to the query
+	err = query.Joins(
+		joinQuery,
+		condition.JoinType,
+		joinValues...,
+	)
+	if err != nil {
+		return err
+	}
+
+	// apply nested joins
+	for _, joinCondition := range joinConditions {
+		err = joinCondition.applyTo(query, t2Table)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -332,6 +332,23 @@ type NotUpdatableNumericField[TModel model.Model, TAttribute Numeric] struct {
 	Field[TModel, TAttribute]
 }
 
+func NewNotUpdatableNumericField[
+	TModel model.Model,
+	TAttribute Numeric,
+](name, column, columnPrefix string) NotUpdatableNumericField[TModel, TAttribute] {
+	return NotUpdatableNumericField[TModel, TAttribute]{
+		Field: NewField[TModel, TAttribute](name, column, columnPrefix),
+	}
+}
+
+// Appearance allows to choose which number of appearance use
+// when field's model is joined more than once.
+func (numericField NotUpdatableNumericField[TModel, TAttribute]) Appearance(number uint) NotUpdatableNumericField[TModel, TAttribute] {
+	return NotUpdatableNumericField[TModel, TAttribute]{
+		Field: numericField.Field.Appearance(number),
+	}
+}
+
 func (numericField NotUpdatableNumericField[TModel, TAttribute]) GetValue() float64 {
 	return 0
 }
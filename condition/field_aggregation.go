@@ -153,6 +153,17 @@ func (aggregation AggregationResult[T]) ToSQL(query *CQLQuery) (string, []any, e
 	return function.ApplyTo(columnSQL, nil), columnValues, nil
 }
 
+// Over applies the aggregation as a window function,
+// calculating it across a set of rows that are related to the current row
+// instead of grouping the rows
+//
+// Example: running sum
+//
+//	conditions.Product.Int.Aggregate().Sum().Over().Ascending(conditions.Product.CreatedAt)
+func (aggregation AggregationResult[T]) Over() WindowFunction[T] {
+	return NewWindowFunction[T](aggregation)
+}
+
 func (aggregation AggregationResult[T]) toSelectSQL(query *CQLQuery, as string) (string, []any, error) {
 	functionSQL, values, err := aggregation.ToSQL(query)
 	if err != nil {
@@ -0,0 +1,158 @@
+package condition
+
+import (
+	"strings"
+
+	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// Field that contains a json document
+// (model.JSON, gorm's datatypes.JSON or fields with the gorm tags type:json, type:jsonb or serializer:json)
+type JSONField[TModel model.Model, TAttribute any] struct {
+	UpdatableField[TModel, TAttribute]
+}
+
+func (jsonField JSONField[TModel, TAttribute]) Is() JSONFieldIs[TModel, TAttribute] {
+	return JSONFieldIs[TModel, TAttribute]{
+		FieldIs:   FieldIs[TModel, TAttribute]{field: jsonField.Field},
+		jsonField: jsonField.Field,
+	}
+}
+
+// Path allows to use the value of the document in the path formed by key and keys (nested keys)
+// after being converted to a type
+func (jsonField JSONField[TModel, TAttribute]) Path(key string, keys ...string) JSONPath[TModel] {
+	return JSONPath[TModel]{
+		field: Field[TModel, any](jsonField.Field),
+		path:  newJSONPathValue(key, keys),
+	}
+}
+
+// Appearance allows to choose which number of appearance use
+// when field's model is joined more than once.
+func (jsonField JSONField[TModel, TAttribute]) Appearance(number uint) JSONField[TModel, TAttribute] {
+	return JSONField[TModel, TAttribute]{
+		UpdatableField: jsonField.UpdatableField.Appearance(number),
+	}
+}
+
+func NewJSONField[TModel model.Model, TAttribute any](name, column, columnPrefix string) JSONField[TModel, TAttribute] {
+	return JSONField[TModel, TAttribute]{
+		UpdatableField: NewUpdatableField[TModel, TAttribute](name, column, columnPrefix),
+	}
+}
+
+type NullableJSONField[TModel model.Model, TAttribute any] struct {
+	JSONField[TModel, TAttribute]
+}
+
+func (jsonField NullableJSONField[TModel, TAttribute]) Set() NullableFieldSet[TModel, TAttribute] {
+	return NullableFieldSet[TModel, TAttribute]{FieldSet[TModel, TAttribute]{field: jsonField.UpdatableField}}
+}
+
+// Appearance allows to choose which number of appearance use
+// when field's model is joined more than once.
+func (jsonField NullableJSONField[TModel, TAttribute]) Appearance(number uint) NullableJSONField[TModel, TAttribute] {
+	return NullableJSONField[TModel, TAttribute]{
+		JSONField: jsonField.JSONField.Appearance(number),
+	}
+}
+
+func NewNullableJSONField[TModel model.Model, TAttribute any](name, column, columnPrefix string) NullableJSONField[TModel, TAttribute] {
+	return NullableJSONField[TModel, TAttribute]{
+		JSONField: NewJSONField[TModel, TAttribute](name, column, columnPrefix),
+	}
+}
+
+type JSONFieldIs[TObject model.Model, TAttribute any] struct {
+	FieldIs[TObject, TAttribute]
+
+	jsonField Field[TObject, TAttribute]
+}
+
+// Contains verifies that the json document contains the document
+// (all its keys and values, in any level of nesting)
+//
+// Available for: postgres, mysql
+//
+// sqlite and sqlserver do not have a function to compare json documents,
+// so ErrUnsupportedByDatabase is returned for them (compare the values of its paths instead)
+func (is JSONFieldIs[TObject, TAttribute]) Contains(document ValueOfType[model.JSON]) WhereCondition[TObject] {
+	return NewFieldCondition[TObject](
+		Field[TObject, bool](is.jsonField.addFunction(sql.JSONContains, document)),
+		Eq[bool](Bool(true)),
+	)
+}
+
+// HasKey verifies that the json document has the key
+// (or the nested keys in the path formed by key and keys, if keys are present)
+//
+// Warning: in sqlserver it is only available since 2022
+func (is JSONFieldIs[TObject, TAttribute]) HasKey(key string, keys ...string) WhereCondition[TObject] {
+	return NewFieldCondition[TObject](
+		Field[TObject, bool](is.jsonField.addFunction(sql.JSONHasKey, newJSONPathValue(key, keys))),
+		Eq[bool](Bool(true)),
+	)
+}
+
+// Value of a json document in a path
+type JSONPath[TModel model.Model] struct {
+	field Field[TModel, any]
+	path  jsonPathValue
+}
+
+// AsString uses the value in the path as a string
+func (path JSONPath[TModel]) AsString() NotUpdatableStringField[TModel] {
+	return NotUpdatableStringField[TModel]{
+		Field: Field[TModel, string](path.field.addFunction(sql.JSONString, path.path)),
+	}
+}
+
+// AsNumeric uses the value in the path as a number
+func (path JSONPath[TModel]) AsNumeric() NotUpdatableNumericField[TModel, float64] {
+	return NotUpdatableNumericField[TModel, float64]{
+		Field: Field[TModel, float64](path.field.addFunction(sql.JSONNumeric, path.path)),
+	}
+}
+
+// AsBool uses the value in the path as a boolean
+func (path JSONPath[TModel]) AsBool() Field[TModel, bool] {
+	return Field[TModel, bool](path.field.addFunction(sql.JSONBool, path.path))
+}
+
+// Path inside a json document, formed by a list of keys
+type jsonPathValue struct {
+	keys []string
+}
+
+func newJSONPathValue(key string, keys []string) jsonPathValue {
+	return jsonPathValue{
+		keys: append([]string{key}, keys...),
+	}
+}
+
+// postgres functions receive each key of the path as a value,
+// the others a json path ($."key1"."key2")
+func (path jsonPathValue) ToSQL(query *CQLQuery) (string, []any, error) {
+	if query.Dialector() == sql.Postgres {
+		placeholders := make([]string, 0, len(path.keys))
+		values := make([]any, 0, len(path.keys))
+
+		for _, key := range path.keys {
+			placeholders = append(placeholders, "?")
+			values = append(values, key)
+		}
+
+		return strings.Join(placeholders, ", "), values, nil
+	}
+
+	jsonPath := strings.Builder{}
+	jsonPath.WriteString("$")
+
+	for _, key := range path.keys {
+		jsonPath.WriteString(`."` + strings.ReplaceAll(key, `"`, `\"`) + `"`)
+	}
+
+	return "?", []any{jsonPath.String()}, nil
+}
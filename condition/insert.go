@@ -179,6 +179,20 @@ func (insertOnConflictSet *InsertOnConflictSet[T]) Exec() (int64, error) {
 	})
 }
 
+// ToSQL returns the sql and values of the statement that Exec would execute, without executing it
+func (insertOnConflictSet *InsertOnConflictSet[T]) ToSQL() (string, []any, error) {
+	insert := insertOnConflictSet.insertOnConflict.insert
+
+	onConflictClause, err := insertOnConflictSet.getOnConflictClause()
+	if err != nil {
+		return "", nil, err
+	}
+
+	insert.addOnConflictClause(onConflictClause)
+
+	return insert.ToSQL()
+}
+
 // ExecInBatches execute the insert statement in batches of batchSize,
 // returning the amount of rows inserted.
 // It will also update the inserted model's primary key in their ids
@@ -286,6 +300,11 @@ func (insertExec *InsertExec[T]) ExecInBatches(batchSize int) (int64, error) {
 	return insertExec.insert.ExecInBatches(batchSize)
 }
 
+// ToSQL returns the sql and values of the statement that Exec would execute, without executing it
+func (insertExec *InsertExec[T]) ToSQL() (string, []any, error) {
+	return insertExec.insert.ToSQL()
+}
+
 // Exec execute the insert statement, returning the amount of rows inserted.
 // It will also update the inserted model's primary key in their ids
 //
@@ -316,3 +335,12 @@ func (insert *Insert[T]) ExecInBatches(batchSize int) (int64, error) {
 
 	return result.RowsAffected, result.Error
 }
+
+// ToSQL returns the sql and values of the statement that Exec would execute, without executing it
+func (insert *Insert[T]) ToSQL() (string, []any, error) {
+	if insert.err != nil {
+		return "", nil, insert.err
+	}
+
+	return statementSQL(dryRunSession(insert.tx).Omit(clause.Associations).Create(insert.models))
+}
@@ -0,0 +1,189 @@
+package condition
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Insert of the results of a query into the table of the model T,
+// executed as a single INSERT INTO ... SELECT statement
+type InsertSelect[T model.Model] struct {
+	tx    *gorm.DB
+	query IQuery
+	sets  []*Set[T]
+	err   error
+}
+
+// Exec executes the insert, returning the amount of models inserted
+func (insertSelect *InsertSelect[T]) Exec() (int64, error) {
+	insertTx, err := insertSelect.insert(insertSelect.tx)
+	if err != nil {
+		return 0, err
+	}
+
+	return insertTx.RowsAffected, insertTx.Error
+}
+
+// ToSQL returns the sql and values of the statement that Exec would execute, without executing it
+func (insertSelect *InsertSelect[T]) ToSQL() (string, []any, error) {
+	insertTx, err := insertSelect.insert(dryRunSession(insertSelect.tx))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return statementSQL(insertTx)
+}
+
+func (insertSelect *InsertSelect[T]) insert(tx *gorm.DB) (*gorm.DB, error) {
+	if insertSelect.err != nil {
+		return nil, insertSelect.err
+	}
+
+	if insertSelect.query.getError() != nil {
+		return nil, insertSelect.query.getError()
+	}
+
+	insertQuery := NewQuery[T](tx).cqlQuery
+	selectQuery := insertSelect.query.getCQLQuery()
+
+	columns := make([]clause.Column, 0, len(insertSelect.sets))
+	selectSQLs := make([]string, 0, len(insertSelect.sets))
+
+	var selectValues []any
+
+	for _, set := range insertSelect.sets {
+		columns = append(columns, clause.Column{
+			Name: set.getField().columnName(insertQuery, insertQuery.initialTable),
+		})
+
+		valueSQL, values, err := insertSelectValue(selectQuery, set)
+		if err != nil {
+			return nil, methodError(err, "InsertSelect")
+		}
+
+		selectSQLs = append(selectSQLs, valueSQL)
+		selectValues = append(selectValues, values...)
+	}
+
+	err := verifyPrimaryKeyColumns[T](tx, columns, "InsertSelect")
+	if err != nil {
+		return nil, err
+	}
+
+	timestampColumns, err := creationTimestampColumns[T](tx, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	now := tx.NowFunc()
+
+	for _, timestampColumn := range timestampColumns {
+		columns = append(columns, timestampColumn)
+		selectSQLs = append(selectSQLs, "?")
+		selectValues = append(selectValues, now)
+	}
+
+	return tx.Exec(
+		"INSERT INTO ? ? ?",
+		clause.Table{Name: insertQuery.initialTable.Name},
+		columns,
+		selectQuery.gormDB.Session(&gorm.Session{}).Select(strings.Join(selectSQLs, ", "), selectValues...),
+	), nil
+}
+
+// Verifies that each primary key field of T is generated by the database
+// (auto increment or default value) or is part of columns,
+// returning an error of the method otherwise
+func verifyPrimaryKeyColumns[T model.Model](tx *gorm.DB, columns []clause.Column, method string) error {
+	modelSchema, err := getSchema(tx, new(T))
+	if err != nil {
+		return err
+	}
+
+	for _, field := range modelSchema.PrimaryFields {
+		if field.AutoIncrement || field.HasDefaultValue || containsColumn(columns, field.DBName) {
+			continue
+		}
+
+		return methodError(primaryKeyNotGeneratedError(field.Name), method)
+	}
+
+	return nil
+}
+
+// Returns the columns of T that are automatically set to the current time when the model is created
+// (created at and updated at) and are not already part of columns
+func creationTimestampColumns[T model.Model](tx *gorm.DB, columns []clause.Column) ([]clause.Column, error) {
+	modelSchema, err := getSchema(tx, new(T))
+	if err != nil {
+		return nil, err
+	}
+
+	timestampColumns := []clause.Column{}
+
+	for _, field := range modelSchema.Fields {
+		if field.DBName == "" || field.DataType != schema.Time ||
+			(field.AutoCreateTime == 0 && field.AutoUpdateTime == 0) {
+			continue
+		}
+
+		if !containsColumn(columns, field.DBName) {
+			timestampColumns = append(timestampColumns, clause.Column{Name: field.DBName})
+		}
+	}
+
+	return timestampColumns, nil
+}
+
+// Returns true if a column called name is part of columns
+func containsColumn(columns []clause.Column, name string) bool {
+	for _, column := range columns {
+		if column.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the sql and values to select the value of the set
+func insertSelectValue(query *CQLQuery, set ISet) (string, []any, error) {
+	value := set.getValue()
+	if value == nil {
+		return "NULL", nil, nil
+	}
+
+	valueSQL, values, err := value.ToSQL(query)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if valueSQL == "" {
+		// static value
+		return "?", values, nil
+	}
+
+	return valueSQL, values, nil
+}
+
+// Create a InsertSelect that inserts into the table of T the results of query,
+// selecting the value of each set into the field of the set, inside transaction tx
+func NewInsertSelect[T model.Model](tx *gorm.DB, query IQuery, sets []*Set[T]) *InsertSelect[T] {
+	var err error
+
+	if len(sets) == 0 {
+		err = methodError(ErrEmptySets, "InsertSelect")
+	}
+
+	return &InsertSelect[T]{
+		tx:    tx,
+		query: query,
+		sets:  sets,
+		err:   err,
+	}
+}
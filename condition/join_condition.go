@@ -2,13 +2,68 @@ package condition
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/elliotchance/pie/v2"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/sql"
 )
 
+// Type of join used to join two models
+type JoinType string
+
+const (
+	// Only the rows that have a match in both models are returned
+	InnerJoin JoinType = "INNER JOIN"
+	// All the rows of the first model are returned, with or without match
+	LeftJoin JoinType = "LEFT JOIN"
+	// All the rows of the joined model are returned, with or without match
+	RightJoin JoinType = "RIGHT JOIN"
+	// All the rows of both models are returned, with or without match
+	FullJoin JoinType = "FULL OUTER JOIN"
+)
+
+var joinTypes = []JoinType{InnerJoin, LeftJoin, RightJoin, FullJoin}
+
+// Returns true if the rows of the joined model are returned even if they do not have a match,
+// in which case the conditions of the joined model can not be applied in the "ON"
+// as they would not filter them
+func (joinType JoinType) preservesJoinedModel() bool {
+	return joinType == RightJoin || joinType == FullJoin
+}
+
+// sqlite supports RIGHT and FULL OUTER joins since 3.39.0
+var sqliteRightAndFullJoinVersion = []int{3, 39, 0}
+
+// Returns an error if the join type is not supported by the database
+func (joinType JoinType) supportedBy(db *gorm.DB) error {
+	if joinType != RightJoin && joinType != FullJoin {
+		return nil
+	}
+
+	switch sql.Dialector(db.Dialector.Name()) {
+	case sql.MySQL:
+		if joinType == FullJoin {
+			return joinTypeError(ErrUnsupportedByDatabase, joinType)
+		}
+	case sql.SQLite:
+		versionLessThan, err := sqliteVersionLessThan(db, sqliteRightAndFullJoinVersion)
+		if err != nil {
+			return err
+		}
+
+		if versionLessThan {
+			return joinTypeError(ErrUnsupportedByDatabase, joinType)
+		}
+	case sql.Postgres, sql.SQLServer:
+	}
+
+	return nil
+}
+
 // Condition that joins T with any other model
 type JoinCondition[T model.Model] interface {
 	Condition[T]
@@ -16,6 +71,31 @@ type JoinCondition[T model.Model] interface {
 	// Preload activates the preloading of the joined model.
 	Preload() JoinCondition[T]
 
+	// InnerJoin joins the model using an INNER JOIN:
+	// only the models that have a match in the joined model are returned.
+	//
+	// This is the default type of join, except when the condition only makes a preload,
+	// in which case LEFT JOIN is used.
+	InnerJoin() JoinCondition[T]
+
+	// LeftJoin joins the model using a LEFT JOIN:
+	// the models are returned even if they do not have a match in the joined model.
+	LeftJoin() JoinCondition[T]
+
+	// RightJoin joins the model using a RIGHT JOIN:
+	// the rows of the joined model are returned even if they do not have a match.
+	// The conditions of the joined model are applied in the WHERE clause, so they filter its rows.
+	//
+	// Available for: postgres, mysql, sqlserver, sqlite (>= 3.39.0)
+	RightJoin() JoinCondition[T]
+
+	// FullJoin joins the model using a FULL OUTER JOIN:
+	// the rows of both models are returned even if they do not have a match.
+	// The conditions of the joined model are applied in the WHERE clause, so they filter its rows.
+	//
+	// Available for: postgres, sqlserver, sqlite (>= 3.39.0)
+	FullJoin() JoinCondition[T]
+
 	// Returns true if this condition or any nested condition makes a preload
 	makesPreload() bool
 
@@ -31,12 +111,34 @@ func NewJoinCondition[T1, T2 model.Model](
 	t1PreloadCondition Condition[T1],
 	t2Field string,
 	t2PreloadCondition Condition[T2],
+) JoinCondition[T1] {
+	return NewCompositeJoinCondition(
+		conditions,
+		relationField,
+		[]string{t1Field},
+		t1PreloadCondition,
+		[]string{t2Field},
+		t2PreloadCondition,
+	)
+}
+
+// Condition that joins T with any other model
+// when the relation is done using several fields (composite foreign key).
+//
+// t1Fields[i] is matched with t2Fields[i].
+func NewCompositeJoinCondition[T1, T2 model.Model](
+	conditions []Condition[T2],
+	relationField string,
+	t1Fields []string,
+	t1PreloadCondition Condition[T1],
+	t2Fields []string,
+	t2PreloadCondition Condition[T2],
 ) JoinCondition[T1] {
 	return joinConditionImpl[T1, T2]{
 		Conditions:         conditions,
 		RelationField:      relationField,
-		T1Field:            t1Field,
-		T2Field:            t2Field,
+		T1Fields:           t1Fields,
+		T2Fields:           t2Fields,
 		T1PreloadCondition: t1PreloadCondition,
 		T2PreloadCondition: t2PreloadCondition,
 		T2Preload:          false,
@@ -45,14 +147,15 @@ func NewJoinCondition[T1, T2 model.Model](
 
 // Implementation of join condition
 type joinConditionImpl[T1, T2 model.Model] struct {
-	T1Field       string
-	T2Field       string
+	T1Fields      []string
+	T2Fields      []string
 	RelationField string
 	Conditions    []Condition[T2]
 
 	T1PreloadCondition Condition[T1] // Condition to preload T1 in case T2 any nested object is preloaded by user
 	T2PreloadCondition Condition[T2] // Condition to preload T2
 	T2Preload          bool          // Indicates if T2PreloadCondition must be applied
+	JoinType           JoinType      // Type of join selected by the user, empty to use the default one
 }
 
 func (condition joinConditionImpl[T1, T2]) Preload() JoinCondition[T1] {
@@ -61,6 +164,30 @@ func (condition joinConditionImpl[T1, T2]) Preload() JoinCondition[T1] {
 	return condition
 }
 
+func (condition joinConditionImpl[T1, T2]) InnerJoin() JoinCondition[T1] {
+	condition.JoinType = InnerJoin
+
+	return condition
+}
+
+func (condition joinConditionImpl[T1, T2]) LeftJoin() JoinCondition[T1] {
+	condition.JoinType = LeftJoin
+
+	return condition
+}
+
+func (condition joinConditionImpl[T1, T2]) RightJoin() JoinCondition[T1] {
+	condition.JoinType = RightJoin
+
+	return condition
+}
+
+func (condition joinConditionImpl[T1, T2]) FullJoin() JoinCondition[T1] {
+	condition.JoinType = FullJoin
+
+	return condition
+}
+
 func (condition joinConditionImpl[T1, T2]) interfaceVerificationMethod(_ T1) {
 	// This method is necessary to get the compiler to verify
 	// that an object is of type Condition[T]
@@ -79,9 +206,11 @@ func (condition joinConditionImpl[T1, T2]) makesPreload() bool {
 func (condition joinConditionImpl[T1, T2]) makesFilter() bool {
 	whereConditions, joinConditions := divideConditionsByType(condition.Conditions)
 
-	return len(whereConditions) != 0 || pie.Any(joinConditions, func(cond JoinCondition[T2]) bool {
-		return cond.makesFilter()
-	})
+	return len(whereConditions) != 0 ||
+		(condition.JoinType != "" && condition.JoinType != LeftJoin) ||
+		pie.Any(joinConditions, func(cond JoinCondition[T2]) bool {
+			return cond.makesFilter()
+		})
 }
 
 // Applies a join between the tables of T1 and T2
@@ -133,6 +262,7 @@ func (condition joinConditionImpl[T1, T2]) applyTo(query *CQLQuery, t1Table Tabl
 }
 
 // Adds the join between t1Table and t2Table to the query and the whereConditions in the "ON"
+// (or in the "WHERE" for joins that preserve the rows of T2)
 func (condition joinConditionImpl[T1, T2]) addJoin(query *CQLQuery, t1Table, t2Table Table, whereConditions []WhereCondition[T2]) error {
 	joinQuery := condition.getSQLJoin(
 		query,
@@ -140,41 +270,76 @@ func (condition joinConditionImpl[T1, T2]) addJoin(query *CQLQuery, t1Table, t2T
 		t2Table,
 	)
 
-	t2Model := *new(T2)
-
 	query.AddConcernedModel(
-		t2Model,
+		*new(T2),
 		t2Table,
 	)
 
-	// apply WhereConditions to the join in the "on" clause
-	connectionCondition := And(whereConditions...)
+	joinType := condition.JoinType
+	if joinType == "" {
+		joinType = InnerJoin
 
-	onQuery, onValues, err := connectionCondition.getSQL(query, t2Table)
+		if len(whereConditions) == 0 && condition.makesPreload() {
+			joinType = LeftJoin
+		}
+	}
+
+	conditionsQuery, conditionsValues, err := joinedModelConditionsSQL(query, t2Table, whereConditions)
 	if err != nil {
 		return err
 	}
 
-	if onQuery != "" {
-		joinQuery += clause.AndWithSpace + onQuery
-	}
+	var onValues []any
 
-	if t2Model.SoftDeleteColumnName() != "" && !connectionCondition.affectsDeletedAt() {
-		joinQuery += fmt.Sprintf(
-			clause.AndWithSpace+"%s.%s IS NULL",
-			t2Table.Alias,
-			t2Model.SoftDeleteColumnName(),
-		)
+	if conditionsQuery != "" {
+		if joinType.preservesJoinedModel() {
+			query.Where(conditionsQuery, conditionsValues...)
+		} else {
+			joinQuery += clause.AndWithSpace + conditionsQuery
+			onValues = conditionsValues
+		}
 	}
 
 	// add the join to the query
-	query.Joins(
+	return query.Joins(
 		joinQuery,
-		len(whereConditions) == 0 && condition.makesPreload(),
+		joinType,
 		onValues...,
 	)
+}
 
-	return nil
+// Returns the sql of the whereConditions of the joined model T2
+// including the filter of the soft deleted models of T2
+// (unless whereConditions already filter by deleted at)
+func joinedModelConditionsSQL[T2 model.Model](
+	query *CQLQuery,
+	t2Table Table,
+	whereConditions []WhereCondition[T2],
+) (string, []any, error) {
+	connectionCondition := And(whereConditions...)
+
+	conditionsQuery, conditionsValues, err := connectionCondition.getSQL(query, t2Table)
+	if err != nil {
+		return "", nil, err
+	}
+
+	t2Model := *new(T2)
+
+	if t2Model.SoftDeleteColumnName() != "" && !connectionCondition.affectsDeletedAt() {
+		softDeleteQuery := fmt.Sprintf(
+			"%s.%s IS NULL",
+			t2Table.Alias,
+			t2Model.SoftDeleteColumnName(),
+		)
+
+		if conditionsQuery != "" {
+			conditionsQuery += clause.AndWithSpace + softDeleteQuery
+		} else {
+			conditionsQuery = softDeleteQuery
+		}
+	}
+
+	return conditionsQuery, conditionsValues, nil
 }
 
 // Returns the SQL string to do a join between T1 and T2
@@ -190,25 +355,32 @@ func (condition joinConditionImpl[T1, T2]) getSQLJoin(
 		`,
 		t2Table.Name,
 		t2Table.Alias,
-		getSQLJoin(query, t1Table, condition.T1Field, t2Table, condition.T2Field),
+		getSQLJoin(query, t1Table, condition.T1Fields, t2Table, condition.T2Fields),
 	)
 }
 
-// Returns the SQL string to verify a join between T1 and T2
+// Returns the SQL string to verify a join between T1 and T2,
+// matching each of the t1Fields with the t2Field in the same position
 func getSQLJoin(
 	query *CQLQuery,
 	t1Table Table,
-	t1Field string,
+	t1Fields []string,
 	t2Table Table,
-	t2Field string,
+	t2Fields []string,
 ) string {
-	return fmt.Sprintf(
-		"%s.%s = %s.%s",
-		t2Table.Alias,
-		query.ColumnName(t2Table, t2Field),
-		t1Table.Alias,
-		query.ColumnName(t1Table, t1Field),
-	)
+	matches := make([]string, 0, len(t1Fields))
+
+	for i, t1Field := range t1Fields {
+		matches = append(matches, fmt.Sprintf(
+			"%s.%s = %s.%s",
+			t2Table.Alias,
+			query.ColumnName(t2Table, t2Fields[i]),
+			t1Table.Alias,
+			query.ColumnName(t1Table, t1Field),
+		))
+	}
+
+	return strings.Join(matches, clause.AndWithSpace)
 }
 
 // Divides a list of conditions by its type: WhereConditions and JoinConditions
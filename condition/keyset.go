@@ -0,0 +1,195 @@
+package condition
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// Order applied to a query
+type order struct {
+	field  IField
+	table  Table
+	column clause.OrderByColumn
+}
+
+// Field used to do a keyset pagination:
+// the order is applied to the field and the value of the field in the last model
+// is used to get the following ones
+type keysetField struct {
+	columnSQL   string
+	schemaField *schema.Field
+	column      clause.OrderByColumn
+}
+
+// Returns a copy of the query to be used in a keyset pagination,
+// so that the orders added to it do not modify the query
+func (query *CQLQuery) keysetCopy() *CQLQuery {
+	gormDB := query.gormDB.WithContext(query.gormDB.Statement.Context)
+	gormDB.Statement.Selects = slices.Clone(gormDB.Statement.Selects)
+
+	return &CQLQuery{
+		gormDB:          gormDB,
+		concernedModels: query.concernedModels,
+		initialTable:    query.initialTable,
+		selectClause: clause.Expr{
+			SQL:  query.selectClause.SQL,
+			Vars: slices.Clone(query.selectClause.Vars),
+		},
+		orders: slices.Clone(query.orders),
+	}
+}
+
+// Returns the fields that will be used for a keyset pagination:
+// the ones used in the order of the query plus the primary keys of the model,
+// if they are not already present, to guarantee a deterministic order.
+//
+// Only fields of the queried model can be used in the order and offset can not be used.
+func (query *CQLQuery) keysetFields(primaryKeys []IField) ([]keysetField, error) {
+	if limitClause, isLimit := query.gormDB.Statement.Clauses["LIMIT"].Expression.(clause.Limit); isLimit && limitClause.Offset > 0 {
+		return nil, ErrKeysetOffsetNotAllowed
+	}
+
+	modelSchema, err := getSchema(query.gormDB, query.gormDB.Statement.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, primaryKey := range primaryKeys {
+		if !query.isOrderedBy(primaryKey) {
+			err = query.Order(primaryKey, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	fields := make([]keysetField, 0, len(query.orders))
+
+	for _, order := range query.orders {
+		if !order.table.IsInitial() {
+			return nil, keysetOrderNotAllowedError(order.field)
+		}
+
+		columnSQL := order.field.columnSQL(query, order.table)
+
+		// fields with functions applied can not be used
+		fieldSQL, _, err := order.field.ToSQLForTable(query, order.table)
+		if err != nil {
+			return nil, err
+		}
+
+		if fieldSQL != columnSQL {
+			return nil, keysetOrderNotAllowedError(order.field)
+		}
+
+		schemaField := modelSchema.LookUpField(order.field.columnName(query, order.table))
+		if schemaField == nil {
+			return nil, keysetOrderNotAllowedError(order.field)
+		}
+
+		fields = append(fields, keysetField{
+			columnSQL:   columnSQL,
+			schemaField: schemaField,
+			column:      order.column,
+		})
+	}
+
+	return fields, nil
+}
+
+// Returns true if the query is already ordered by the field
+func (query *CQLQuery) isOrderedBy(field IField) bool {
+	for _, order := range query.orders {
+		if order.table.IsInitial() &&
+			order.field.getModelType() == field.getModelType() &&
+			order.field.columnName(query, order.table) == field.columnName(query, order.table) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the values of the keyset fields in the model
+func keysetValues(ctx context.Context, fields []keysetField, model any) []any {
+	modelValue := reflect.Indirect(reflect.ValueOf(model))
+
+	values := make([]any, 0, len(fields))
+
+	for _, field := range fields {
+		value, _ := field.schemaField.ValueOf(ctx, modelValue)
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Returns the condition that models must fulfill to be after the model with values in the keyset order:
+//
+// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+//
+// if backwards is true, the condition is the one to be before the model in the keyset order
+func keysetCondition(fields []keysetField, values []any, backwards bool) (string, []any) {
+	orSQLs := make([]string, 0, len(fields))
+	orValues := []any{}
+
+	for i, field := range fields {
+		andSQLs := make([]string, 0, i+1)
+
+		for _, previousField := range fields[:i] {
+			andSQLs = append(andSQLs, previousField.columnSQL+" "+sql.Eq.String()+" ?")
+		}
+
+		operator := sql.Gt
+		if field.column.Desc != backwards {
+			operator = sql.Lt
+		}
+
+		andSQLs = append(andSQLs, field.columnSQL+" "+operator.String()+" ?")
+
+		orSQLs = append(orSQLs, connectSQLs(andSQLs, sql.And.String()))
+		orValues = append(orValues, values[:i+1]...)
+	}
+
+	return "(" + strings.Join(orSQLs, " "+sql.Or.String()+" ") + ")", orValues
+}
+
+// Finds the models that are after the model with values in the keyset order
+//
+// if values is nil, the first models are returned
+//
+// if backwards is true, the models before the model with values are returned,
+// in the inverse order
+func (query *CQLQuery) findKeyset(dest any, fields []keysetField, values []any, limit int, backwards bool) error {
+	gormDB := query.gormDB.Session(&gorm.Session{})
+
+	if values != nil {
+		keysetSQL, keysetValues := keysetCondition(fields, values, backwards)
+		gormDB = gormDB.Where(keysetSQL, keysetValues...)
+	}
+
+	if backwards {
+		inverseColumns := make([]clause.OrderByColumn, 0, len(fields))
+
+		for _, field := range fields {
+			inverseColumn := field.column
+			inverseColumn.Desc = !inverseColumn.Desc
+			inverseColumns = append(inverseColumns, inverseColumn)
+		}
+
+		// replace the order of the query
+		inverseColumns[0].Reorder = true
+
+		gormDB = gormDB.Clauses(clause.OrderBy{Columns: inverseColumns})
+	}
+
+	return gormDB.Limit(limit).Find(dest).Error
+}
@@ -0,0 +1,108 @@
+package condition
+
+import (
+	"context"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Model previously loaded from the database that is going to be updated or deleted
+type loadedModel[T model.Model] struct {
+	model *T
+	// field that contains the version of the model (nil if the model does not use optimistic locking)
+	versionField *schema.Field
+	ctx          context.Context
+}
+
+// Returns the loadedModel and the conditions that identify it in the database:
+// its primary key and, if the model uses optimistic locking, its version
+func newLoadedModel[T model.Model](tx *gorm.DB, loaded *T) (*loadedModel[T], []Condition[T], error) {
+	if !(*loaded).IsLoaded() {
+		return nil, nil, ErrModelNotLoaded
+	}
+
+	modelSchema, err := getSchema(tx, loaded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx := tx.Statement.Context
+	modelValue := reflect.ValueOf(loaded).Elem()
+
+	conditions := []Condition[T]{}
+
+	for _, primaryField := range modelSchema.PrimaryFields {
+		conditions = append(conditions, fieldEqualsCondition[T](ctx, primaryField, modelValue))
+	}
+
+	loadedModel := &loadedModel[T]{
+		model: loaded,
+		ctx:   ctx,
+	}
+
+	if versionColumn := versionColumnName(*loaded); versionColumn != "" {
+		loadedModel.versionField = modelSchema.LookUpField(versionColumn)
+		if loadedModel.versionField != nil {
+			conditions = append(conditions, fieldEqualsCondition[T](ctx, loadedModel.versionField, modelValue))
+		}
+	}
+
+	return loadedModel, conditions, nil
+}
+
+// Returns a condition that verifies that the value of the field in the database
+// is equal to the value of the field in modelValue
+func fieldEqualsCondition[T model.Model](ctx context.Context, field *schema.Field, modelValue reflect.Value) Condition[T] {
+	value, _ := field.ValueOf(ctx, modelValue)
+
+	return NewFieldCondition[T, any](
+		NewField[T, any](field.Name, field.DBName, ""),
+		Eq[any](Value[any]{Value: value}),
+	)
+}
+
+// Verifies that the statement executed affected the loaded model,
+// in other case it was modified or deleted since it was loaded
+func (loaded *loadedModel[T]) verifyAffected(rowsAffected int64) error {
+	if loaded.versionField != nil && rowsAffected == 0 {
+		return ErrStaleObject
+	}
+
+	return nil
+}
+
+// Increments the version of the loaded model, as it was incremented in the database by the update
+func (loaded *loadedModel[T]) incrementVersion() error {
+	if loaded.versionField == nil {
+		return nil
+	}
+
+	return incrementVersion(loaded.ctx, loaded.versionField, reflect.ValueOf(loaded.model).Elem())
+}
+
+// Increments the value of the version field of the model
+func incrementVersion(ctx context.Context, versionField *schema.Field, modelValue reflect.Value) error {
+	version, _ := versionField.ValueOf(ctx, modelValue)
+
+	versionUint, isUint := version.(uint)
+	if !isUint {
+		return nil
+	}
+
+	return versionField.Set(ctx, modelValue, versionUint+1)
+}
+
+// Returns the name of the column that contains the version of the model
+// or empty string if the model does not use optimistic locking
+func versionColumnName(entity model.Model) string {
+	versionedModel, isVersioned := entity.(model.VersionedModel)
+	if !isVersioned {
+		return ""
+	}
+
+	return versionedModel.VersionColumnName()
+}
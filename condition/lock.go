@@ -0,0 +1,176 @@
+package condition
+
+import (
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// Lock of the rows obtained by a query
+type Lock struct {
+	strength   string
+	skipLocked bool
+	noWait     bool
+	of         []reflect.Type
+}
+
+// Option that modifies the behavior of a Lock
+type LockOption func(lock *Lock)
+
+// SkipLocked makes the rows that are already locked by other transactions to be skipped
+// instead of waiting for them to be released
+func SkipLocked() LockOption {
+	return func(lock *Lock) {
+		lock.skipLocked = true
+	}
+}
+
+// NoWait makes the query to fail if any of the rows is already locked by other transaction
+// instead of waiting for it to be released
+func NoWait() LockOption {
+	return func(lock *Lock) {
+		lock.noWait = true
+	}
+}
+
+// LockOf limits the lock to the rows of the model T
+// (all its appearances if it is joined more than once).
+//
+// It can be used more than once to lock the rows of multiple models
+func LockOf[T model.Model]() LockOption {
+	return func(lock *Lock) {
+		lock.of = append(lock.of, reflect.TypeOf(*new(T)))
+	}
+}
+
+func newLock(strength string, options []LockOption) Lock {
+	lock := Lock{strength: strength}
+
+	for _, option := range options {
+		option(&lock)
+	}
+
+	return lock
+}
+
+// Applies the lock to the rows obtained by the query
+//
+// Available for: postgres, mysql, sqlserver
+func (query *CQLQuery) Lock(lock Lock) error {
+	tables, err := query.lockedTables(lock)
+	if err != nil {
+		return err
+	}
+
+	switch query.Dialector() {
+	case sql.Postgres, sql.MySQL:
+		locking := clause.Locking{Strength: lock.strength}
+
+		if len(lock.of) > 0 {
+			locking.Table = clause.Table{
+				Name: strings.Join(tableAliases(tables), ", "),
+				Raw:  true,
+			}
+		}
+
+		switch {
+		case lock.skipLocked:
+			locking.Options = clause.LockingOptionsSkipLocked
+		case lock.noWait:
+			locking.Options = clause.LockingOptionsNoWait
+		}
+
+		query.gormDB = query.gormDB.Clauses(locking)
+	case sql.SQLServer:
+		query.addTableHints(lock, tables)
+	case sql.SQLite:
+		return ErrUnsupportedByDatabase
+	}
+
+	return nil
+}
+
+// Returns the tables whose rows must be locked:
+// the ones of the models selected with LockOf or all the tables of the query if none is selected
+func (query *CQLQuery) lockedTables(lock Lock) ([]Table, error) {
+	if len(lock.of) == 0 {
+		tables := []Table{}
+
+		for _, modelTables := range query.concernedModels {
+			tables = append(tables, modelTables...)
+		}
+
+		return tables, nil
+	}
+
+	tables := []Table{}
+
+	for _, modelType := range lock.of {
+		modelTables := query.GetTables(modelType)
+		if modelTables == nil {
+			return nil, lockOfModelNotConcernedError(modelType)
+		}
+
+		tables = append(tables, modelTables...)
+	}
+
+	return tables, nil
+}
+
+// sqlserver does not support FOR UPDATE, so locks are applied with hints on each locked table
+func (query *CQLQuery) addTableHints(lock Lock, tables []Table) {
+	hints := []string{"UPDLOCK", "ROWLOCK"}
+	if lock.strength == clause.LockingStrengthShare {
+		hints = []string{"HOLDLOCK", "ROWLOCK"}
+	}
+
+	switch {
+	case lock.skipLocked:
+		hints = append(hints, "READPAST")
+	case lock.noWait:
+		hints = append(hints, "NOWAIT")
+	}
+
+	withHints := " WITH (" + strings.Join(hints, ", ") + ")"
+
+	for _, table := range tables {
+		if table == query.initialTable {
+			tableExpression := table.Name
+			tableValues := []any{}
+
+			if query.gormDB.Statement.TableExpr != nil {
+				tableExpression = query.gormDB.Statement.TableExpr.SQL
+				tableValues = query.gormDB.Statement.TableExpr.Vars
+			}
+
+			query.gormDB = query.gormDB.Table(tableExpression+withHints, tableValues...)
+
+			continue
+		}
+
+		tableInJoin := table.Name + " " + table.Alias + " ON "
+
+		for i, join := range query.gormDB.Statement.Joins {
+			query.gormDB.Statement.Joins[i].Name = strings.Replace(
+				join.Name,
+				tableInJoin,
+				table.Name+" "+table.Alias+withHints+" ON ",
+				1,
+			)
+		}
+	}
+}
+
+func tableAliases(tables []Table) []string {
+	aliases := make([]string, 0, len(tables))
+
+	for _, table := range tables {
+		aliases = append(aliases, table.SQLName())
+	}
+
+	return aliases
+}
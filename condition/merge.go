@@ -0,0 +1,377 @@
+package condition
+
+import (
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// name of the table merged by a MERGE statement
+const mergeTargetTable = "cql_target"
+
+// name of the derived table that contains the results of the source query of a MERGE statement
+const mergeSourceTable = "cql_source"
+
+// postgres supports MERGE since 15
+var postgresMergeVersion = []int{15}
+
+// Action taken by a MERGE statement when a row of the source matches a row of the target
+type mergeMatchedAction int
+
+const (
+	mergeMatchedNothing mergeMatchedAction = iota
+	mergeMatchedUpdate
+	mergeMatchedDelete
+)
+
+// Merge of the results of a query (the source) into the table of the model T (the target)
+type Merge[T model.Model] struct {
+	tx    *gorm.DB
+	query IQuery
+	sets  []*Set[T]
+	err   error
+}
+
+// On specifies the fields used to match the rows of the source with the models of the target:
+// a row matches a model if the values set to the fields are equal to the values of the model.
+//
+// The fields must be set by one of the sets of the merge.
+func (merge *Merge[T]) On(field FieldOfModel[T], fields ...FieldOfModel[T]) *MergeOn[T] {
+	return &MergeOn[T]{
+		merge: merge,
+		on:    append([]FieldOfModel[T]{field}, fields...),
+	}
+}
+
+// Merge with the fields used to match the source and the target
+type MergeOn[T model.Model] struct {
+	merge *Merge[T]
+	on    []FieldOfModel[T]
+
+	matchedAction       mergeMatchedAction
+	matchedUpdateFields []FieldOfModel[T]
+	notMatchedInsert    bool
+}
+
+// WhenMatchedUpdate updates the fields of the models that match a row of the source
+// with the values set to them (that must be set by one of the sets of the merge).
+// If the model uses optimistic locking (embeds model.Versioned), its version is also incremented.
+//
+// It replaces the action set by WhenMatchedDelete, if any.
+func (mergeOn *MergeOn[T]) WhenMatchedUpdate(field FieldOfModel[T], fields ...FieldOfModel[T]) *MergeOn[T] {
+	mergeOn.matchedAction = mergeMatchedUpdate
+	mergeOn.matchedUpdateFields = append([]FieldOfModel[T]{field}, fields...)
+
+	return mergeOn
+}
+
+// WhenMatchedDelete deletes the models that match a row of the source
+// (soft delete if the model supports it).
+//
+// It replaces the action set by WhenMatchedUpdate, if any.
+func (mergeOn *MergeOn[T]) WhenMatchedDelete() *MergeOn[T] {
+	mergeOn.matchedAction = mergeMatchedDelete
+	mergeOn.matchedUpdateFields = nil
+
+	return mergeOn
+}
+
+// WhenNotMatchedInsert inserts a model for each row of the source that does not match any model,
+// with the values of the sets of the merge.
+// The primary key of T must be generated by the database, otherwise ErrPrimaryKeyNotGenerated is returned
+func (mergeOn *MergeOn[T]) WhenNotMatchedInsert() *MergeOn[T] {
+	mergeOn.notMatchedInsert = true
+
+	return mergeOn
+}
+
+// Exec executes the merge, returning the amount of models inserted, updated or deleted
+func (mergeOn *MergeOn[T]) Exec() (int64, error) {
+	mergeTx, err := mergeOn.statement(mergeOn.merge.tx)
+	if err != nil {
+		return 0, methodError(err, "Merge")
+	}
+
+	return mergeTx.RowsAffected, mergeTx.Error
+}
+
+// ToSQL returns the sql and values of the statement that Exec would execute, without executing it
+func (mergeOn *MergeOn[T]) ToSQL() (string, []any, error) {
+	mergeTx, err := mergeOn.statement(dryRunSession(mergeOn.merge.tx))
+	if err != nil {
+		return "", nil, methodError(err, "Merge")
+	}
+
+	return statementSQL(mergeTx)
+}
+
+// MERGE INTO table AS cql_target USING (SELECT ...) AS cql_source ON cql_target.column = cql_source.column
+// WHEN MATCHED THEN UPDATE SET ... | DELETE
+// WHEN NOT MATCHED THEN INSERT (...) VALUES (...)
+func (mergeOn *MergeOn[T]) statement(tx *gorm.DB) (*gorm.DB, error) {
+	// verified using the original transaction as the version of the database can not be obtained in dry run mode
+	err := mergeOn.verify(mergeOn.merge.tx)
+	if err != nil {
+		return nil, err
+	}
+
+	targetQuery := NewQuery[T](tx).cqlQuery
+
+	source, err := mergeOn.source(tx, targetQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	targetColumn := func(field IField) clause.Column {
+		return clause.Column{Table: mergeTargetTable, Name: field.columnName(targetQuery, targetQuery.initialTable)}
+	}
+
+	sourceColumn := func(field IField) clause.Column {
+		return clause.Column{Table: mergeSourceTable, Name: field.columnName(targetQuery, targetQuery.initialTable)}
+	}
+
+	matches := make([]string, 0, len(mergeOn.on))
+	values := []any{clause.Table{Name: targetQuery.initialTable.Name}, source}
+
+	for _, field := range mergeOn.on {
+		matches = append(matches, "? = ?")
+		values = append(values, targetColumn(field), sourceColumn(field))
+	}
+
+	statementSQL := strings.Builder{}
+	statementSQL.WriteString(
+		"MERGE INTO ? AS " + mergeTargetTable +
+			" USING (?) AS " + mergeSourceTable +
+			" ON " + strings.Join(matches, " AND "),
+	)
+
+	entity := *new(T)
+	now := tx.NowFunc()
+
+	// soft deleted models are ignored, as in the other statements
+	matchedSQL := " WHEN MATCHED"
+
+	softDeleteColumn := entity.SoftDeleteColumnName()
+	if softDeleteColumn != "" {
+		softDeleteColumn = targetQuery.ColumnName(targetQuery.initialTable, softDeleteColumn)
+
+		matchedSQL += " AND ? IS NULL"
+	}
+
+	if mergeOn.matchedAction != mergeMatchedNothing {
+		statementSQL.WriteString(matchedSQL)
+
+		if softDeleteColumn != "" {
+			values = append(values, clause.Column{Table: mergeTargetTable, Name: softDeleteColumn})
+		}
+	}
+
+	switch mergeOn.matchedAction {
+	case mergeMatchedUpdate:
+		sets := make([]string, 0, len(mergeOn.matchedUpdateFields)+1)
+
+		for _, field := range mergeOn.matchedUpdateFields {
+			sets = append(sets, "? = ?")
+			values = append(values, clause.Column{Name: field.columnName(targetQuery, targetQuery.initialTable)}, sourceColumn(field))
+		}
+
+		if updatedAtColumn := entity.UpdatedAtColumnName(); updatedAtColumn != "" && !mergeOn.updates(targetQuery, updatedAtColumn) {
+			sets = append(sets, "? = ?")
+			values = append(values, clause.Column{Name: targetQuery.ColumnName(targetQuery.initialTable, updatedAtColumn)}, now)
+		}
+
+		// the version of the updated models is incremented, so the loaded copies of them become stale
+		if versionColumn := versionColumnName(entity); versionColumn != "" {
+			versionColumn = targetQuery.ColumnName(targetQuery.initialTable, versionColumn)
+
+			sets = append(sets, "? = ? + 1")
+			values = append(values, clause.Column{Name: versionColumn}, clause.Column{Table: mergeTargetTable, Name: versionColumn})
+		}
+
+		statementSQL.WriteString(" THEN UPDATE SET " + strings.Join(sets, ", "))
+	case mergeMatchedDelete:
+		if softDeleteColumn != "" {
+			statementSQL.WriteString(" THEN UPDATE SET ? = ?")
+
+			values = append(values, clause.Column{Name: softDeleteColumn}, now)
+		} else {
+			statementSQL.WriteString(" THEN DELETE")
+		}
+	case mergeMatchedNothing:
+	}
+
+	if mergeOn.notMatchedInsert {
+		insertSQL, insertValues, err := mergeOn.insert(tx, targetQuery, now)
+		if err != nil {
+			return nil, err
+		}
+
+		statementSQL.WriteString(insertSQL)
+
+		values = append(values, insertValues...)
+	}
+
+	if targetQuery.Dialector() == sql.SQLServer {
+		// sqlserver requires MERGE statements to be terminated by a semicolon
+		statementSQL.WriteString(";")
+	}
+
+	return tx.Exec(statementSQL.String(), values...), nil
+}
+
+// Returns an error if the merge can not be executed
+func (mergeOn *MergeOn[T]) verify(tx *gorm.DB) error {
+	if mergeOn.merge.err != nil {
+		return mergeOn.merge.err
+	}
+
+	if mergeOn.merge.query.getError() != nil {
+		return mergeOn.merge.query.getError()
+	}
+
+	if mergeOn.matchedAction == mergeMatchedNothing && !mergeOn.notMatchedInsert {
+		return ErrMergeWithoutActions
+	}
+
+	targetQuery := NewQuery[T](tx).cqlQuery
+
+	for _, field := range slices.Concat(mergeOn.on, mergeOn.matchedUpdateFields) {
+		if !mergeOn.updates(targetQuery, field.columnName(targetQuery, targetQuery.initialTable)) {
+			return fieldNotSetError(field)
+		}
+	}
+
+	if mergeOn.notMatchedInsert {
+		err := verifyPrimaryKeyColumns[T](tx, mergeOn.insertColumns(targetQuery), "WhenNotMatchedInsert")
+		if err != nil {
+			return err
+		}
+	}
+
+	switch sql.Dialector(tx.Dialector.Name()) {
+	case sql.Postgres:
+		versionLessThan, err := postgresVersionLessThan(tx, postgresMergeVersion)
+		if err != nil {
+			return err
+		}
+
+		if versionLessThan {
+			return ErrUnsupportedByDatabase
+		}
+	case sql.SQLServer:
+	case sql.MySQL, sql.SQLite:
+		return ErrUnsupportedByDatabase
+	}
+
+	return nil
+}
+
+// Returns true if the column is set by one of the sets of the merge
+func (mergeOn *MergeOn[T]) updates(targetQuery *CQLQuery, column string) bool {
+	for _, set := range mergeOn.merge.sets {
+		if set.getField().columnName(targetQuery, targetQuery.initialTable) == column {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the source query, that selects the value of each set with the name of the column of its field
+func (mergeOn *MergeOn[T]) source(tx *gorm.DB, targetQuery *CQLQuery) (*gorm.DB, error) {
+	sourceQuery := mergeOn.merge.query.getCQLQuery()
+
+	modelSchema, err := getSchema(tx, new(T))
+	if err != nil {
+		return nil, err
+	}
+
+	selectSQLs := make([]string, 0, len(mergeOn.merge.sets))
+
+	var selectValues []any
+
+	for _, set := range mergeOn.merge.sets {
+		column := set.getField().columnName(targetQuery, targetQuery.initialTable)
+
+		valueSQL, values, err := insertSelectValue(sourceQuery, set)
+		if err != nil {
+			return nil, err
+		}
+
+		if targetQuery.Dialector() == sql.Postgres {
+			// postgres can not infer the type of the values of a derived table
+			if field := modelSchema.LookUpField(column); field != nil {
+				valueSQL = "CAST(" + valueSQL + " AS " + columnDataType(tx, field) + ")"
+			}
+		}
+
+		selectSQLs = append(selectSQLs, valueSQL+" AS ?")
+		selectValues = append(selectValues, values...)
+		selectValues = append(selectValues, clause.Column{Name: column})
+	}
+
+	return sourceQuery.gormDB.Session(&gorm.Session{}).Select(strings.Join(selectSQLs, ", "), selectValues...), nil
+}
+
+// Returns the WHEN NOT MATCHED clause, that inserts the values of the sets
+// and sets the created at and updated at of the model to now
+func (mergeOn *MergeOn[T]) insert(tx *gorm.DB, targetQuery *CQLQuery, now any) (string, []any, error) {
+	columns := mergeOn.insertColumns(targetQuery)
+	insertSQLs := make([]string, 0, len(columns))
+	insertValues := make([]any, 0, len(columns))
+
+	for _, column := range columns {
+		insertSQLs = append(insertSQLs, "?")
+		insertValues = append(insertValues, clause.Column{Table: mergeSourceTable, Name: column.Name})
+	}
+
+	timestampColumns, err := creationTimestampColumns[T](tx, columns)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, timestampColumn := range timestampColumns {
+		columns = append(columns, timestampColumn)
+		insertSQLs = append(insertSQLs, "?")
+		insertValues = append(insertValues, now)
+	}
+
+	return " WHEN NOT MATCHED THEN INSERT ? VALUES (" + strings.Join(insertSQLs, ", ") + ")",
+		append([]any{columns}, insertValues...),
+		nil
+}
+
+// Returns the columns of the target set by the sets of the merge
+func (mergeOn *MergeOn[T]) insertColumns(targetQuery *CQLQuery) []clause.Column {
+	columns := make([]clause.Column, 0, len(mergeOn.merge.sets))
+
+	for _, set := range mergeOn.merge.sets {
+		columns = append(columns, clause.Column{
+			Name: set.getField().columnName(targetQuery, targetQuery.initialTable),
+		})
+	}
+
+	return columns
+}
+
+// Create a Merge that merges the results of query (the source) into the table of T (the target),
+// selecting the value of each set into the field of the set, inside transaction tx
+func NewMerge[T model.Model](tx *gorm.DB, query IQuery, sets []*Set[T]) *Merge[T] {
+	var err error
+
+	if len(sets) == 0 {
+		err = ErrEmptySets
+	}
+
+	return &Merge[T]{
+		tx:    tx,
+		query: query,
+		sets:  sets,
+		err:   err,
+	}
+}
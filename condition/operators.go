@@ -1,6 +1,8 @@
 package condition
 
 import (
+	"strings"
+
 	"github.com/FrancoLiberali/cql/sql"
 )
 
@@ -106,18 +108,44 @@ func IsNotDistinct[T any](value IValue) Operator[T] {
 
 type IValueList[T any] []ValueOfType[T]
 
+type subqueryValue interface {
+	isSubquery()
+}
+
 func (values IValueList[T]) ToSQL(query *CQLQuery) (string, []any, error) {
+	// a subquery can be used as the complete list of values
+	if len(values) == 1 {
+		if _, isSubquery := values[0].(subqueryValue); isSubquery {
+			return values[0].ToSQL(query)
+		}
+	}
+
+	valuesSQLs := make([]string, 0, len(values))
 	valuesAny := make([]any, 0, len(values))
+	hasSQL := false
 
 	for _, value := range values {
-		_, valueValues, err := value.ToSQL(query)
+		valueSQL, valueValues, err := value.ToSQL(query)
 		if err != nil {
 			return "", nil, err
 		}
 
+		if valueSQL != "" {
+			hasSQL = true
+
+			valuesSQLs = append(valuesSQLs, valueSQL)
+		} else {
+			valuesSQLs = append(valuesSQLs, "?")
+		}
+
 		valuesAny = append(valuesAny, valueValues...)
 	}
 
+	// values that are not static (fields, subqueries) are written inside the list
+	if hasSQL {
+		return "(" + strings.Join(valuesSQLs, ", ") + ")", valuesAny, nil
+	}
+
 	return "", valuesAny, nil
 }
 
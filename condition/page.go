@@ -0,0 +1,113 @@
+package condition
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"hash/fnv"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Page of models obtained using Paginate
+type Page[T model.Model] struct {
+	Items []*T
+	// Token to obtain the next page, empty if this is the last page
+	NextToken string
+	// Token to obtain the previous page, empty if this is the first page
+	PrevToken string
+}
+
+// Information saved inside a page token
+type pageToken struct {
+	// Hash of the order used to generate the token
+	Order string `json:"o"`
+	// Values of the keyset fields of the model used as reference
+	Values []json.RawMessage `json:"v"`
+	// True if the token is to get the models before the reference
+	Backwards bool `json:"b,omitempty"`
+}
+
+// Returns an identifier of the order of the keyset fields
+func keysetOrderHash(fields []keysetField) string {
+	orderStrings := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		direction := "ASC"
+		if field.column.Desc {
+			direction = "DESC"
+		}
+
+		orderStrings = append(orderStrings, field.columnSQL+" "+direction)
+	}
+
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(strings.Join(orderStrings, ",")))
+
+	return strconv.FormatUint(hash.Sum64(), 16)
+}
+
+// Generates an opaque token that allows to obtain the models after (or before if backwards)
+// the reference model in the keyset order
+func encodePageToken(fields []keysetField, values []any, backwards bool) (string, error) {
+	rawValues := make([]json.RawMessage, 0, len(values))
+
+	for _, value := range values {
+		rawValue, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+
+		rawValues = append(rawValues, rawValue)
+	}
+
+	token, err := json.Marshal(pageToken{
+		Order:     keysetOrderHash(fields),
+		Values:    rawValues,
+		Backwards: backwards,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Decodes a token generated by encodePageToken,
+// returning the values of the reference model and if the token is backwards
+//
+// Returns error if the token was generated for a different order
+func decodePageToken(fields []keysetField, token string) ([]any, bool, error) {
+	tokenJSON, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, false, invalidPageTokenError(err)
+	}
+
+	var decodedToken pageToken
+
+	err = json.Unmarshal(tokenJSON, &decodedToken)
+	if err != nil {
+		return nil, false, invalidPageTokenError(err)
+	}
+
+	if decodedToken.Order != keysetOrderHash(fields) || len(decodedToken.Values) != len(fields) {
+		return nil, false, ErrPageTokenOrderMismatch
+	}
+
+	values := make([]any, 0, len(fields))
+
+	for i, field := range fields {
+		value := reflect.New(field.schemaField.FieldType)
+
+		err = json.Unmarshal(decodedToken.Values[i], value.Interface())
+		if err != nil {
+			return nil, false, invalidPageTokenError(err)
+		}
+
+		values = append(values, value.Elem().Interface())
+	}
+
+	return values, decodedToken.Backwards, nil
+}
@@ -1,7 +1,11 @@
 package condition
 
 import (
+	"iter"
+
+	"github.com/elliotchance/pie/v2"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/FrancoLiberali/cql/model"
 )
@@ -65,6 +69,31 @@ func (query *Query[T]) Offset(offset int) *Query[T] {
 	return query
 }
 
+// WithDeleted makes the query to also return the models that were soft deleted
+func (query *Query[T]) WithDeleted() *Query[T] {
+	if query.err == nil {
+		query.cqlQuery.Unscoped()
+	}
+
+	return query
+}
+
+// OnlyDeleted makes the query to return only the models that were soft deleted.
+//
+// If the model does not support soft delete, ErrNotSoftDeletable is returned
+func (query *Query[T]) OnlyDeleted() *Query[T] {
+	if query.err != nil {
+		return query
+	}
+
+	err := query.cqlQuery.OnlyDeleted((*new(T)).SoftDeleteColumnName())
+	if err != nil {
+		query.addError(methodError(err, "OnlyDeleted"))
+	}
+
+	return query
+}
+
 // GroupBy arrange identical data into groups
 func (query *Query[T]) GroupBy(fields ...IField) *QueryGroup {
 	query.addError(query.cqlQuery.GroupBy(fields))
@@ -76,6 +105,76 @@ func (query *Query[T]) GroupBy(fields ...IField) *QueryGroup {
 	}
 }
 
+// Union combines the models of the query with the ones of other, removing duplicates
+//
+// Order and limit can be applied to the resulting query but not to the combined queries
+// (ErrSetOperationOrderOrLimit is returned).
+func (query *Query[T]) Union(other *Query[T]) *Query[T] {
+	return newSetOperationQuery(union, query, other)
+}
+
+// UnionAll combines the models of the query with the ones of other, without removing duplicates
+//
+// Order and limit can be applied to the resulting query but not to the combined queries
+// (ErrSetOperationOrderOrLimit is returned).
+func (query *Query[T]) UnionAll(other *Query[T]) *Query[T] {
+	return newSetOperationQuery(unionAll, query, other)
+}
+
+// Intersect returns the models of the query that are also returned by other
+//
+// Order and limit can be applied to the resulting query but not to the combined queries
+// (ErrSetOperationOrderOrLimit is returned).
+//
+// Available for: postgres, sqlite, sqlserver, mysql (>= 8.0.31)
+func (query *Query[T]) Intersect(other *Query[T]) *Query[T] {
+	return newSetOperationQuery(intersect, query, other)
+}
+
+// Except returns the models of the query that are not returned by other
+//
+// Order and limit can be applied to the resulting query but not to the combined queries
+// (ErrSetOperationOrderOrLimit is returned).
+//
+// Available for: postgres, sqlite, sqlserver, mysql (>= 8.0.31)
+func (query *Query[T]) Except(other *Query[T]) *Query[T] {
+	return newSetOperationQuery(except, query, other)
+}
+
+// ForUpdate locks the rows obtained by the query until the end of the transaction,
+// so other transactions can not modify or lock them
+//
+// Options SkipLocked, NoWait and LockOf can be used to modify the lock.
+//
+// Available for: postgres, mysql, sqlserver
+func (query *Query[T]) ForUpdate(options ...LockOption) *Query[T] {
+	return query.lock(clause.LockingStrengthUpdate, options)
+}
+
+// ForShare locks the rows obtained by the query until the end of the transaction,
+// so other transactions can read but not modify them
+//
+// Options SkipLocked, NoWait and LockOf can be used to modify the lock.
+//
+// Available for: postgres, mysql, sqlserver
+func (query *Query[T]) ForShare(options ...LockOption) *Query[T] {
+	return query.lock(clause.LockingStrengthShare, options)
+}
+
+func (query *Query[T]) lock(strength string, options []LockOption) *Query[T] {
+	err := query.cqlQuery.Lock(newLock(strength, options))
+	if err != nil {
+		methodName := "ForUpdate"
+		if strength == clause.LockingStrengthShare {
+			methodName = "ForShare"
+		}
+
+		query.addError(methodError(err, methodName))
+	}
+
+	return query
+}
+
 // Finishing methods
 
 // Count returns the amount of models that fulfill the conditions
@@ -152,6 +251,291 @@ func (query *Query[T]) Find() ([]*T, error) {
 	return models, query.cqlQuery.Find(&models)
 }
 
+// FindOneTracked finds the only one model that matches given conditions
+// or returns error if 0 or more than 1 are found (see FindOne).
+//
+// The model is returned alongside a snapshot of its values (and the ones of its preloaded relations),
+// so SaveTracked only persists the fields that change
+func (query *Query[T]) FindOneTracked() (*Tracked[T], error) {
+	models, err := query.FindTracked()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(models) == 1:
+		return models[0], nil
+	case len(models) == 0:
+		return nil, ErrObjectNotFound
+	default:
+		return nil, ErrMoreThanOneObjectFound
+	}
+}
+
+// FindTracked finds all models matching given conditions (see Find).
+//
+// Each model is returned alongside a snapshot of its values (and the ones of its preloaded relations),
+// so SaveTracked only persists the fields that change
+func (query *Query[T]) FindTracked() ([]*Tracked[T], error) {
+	models, err := query.Find()
+	if err != nil {
+		return nil, err
+	}
+
+	return trackAll(query.cqlQuery.gormDB, models)
+}
+
+// ToSQL returns the sql and values of the statement that Find would execute, without executing it
+func (query *Query[T]) ToSQL() (string, []any, error) {
+	if query.err != nil {
+		return "", nil, query.err
+	}
+
+	return query.cqlQuery.ToSQL(func() (*gorm.DB, error) {
+		var models []*T
+
+		return query.cqlQuery.gormDB.Find(&models), nil
+	})
+}
+
+// Explain returns the execution plan that the database will use to execute the statement generated by Find.
+//
+// EXPLAIN ANALYZE is used in postgres (so the statement is executed), EXPLAIN in mysql
+// and EXPLAIN QUERY PLAN in sqlite.
+//
+// Available for: postgres, mysql, sqlite
+func (query *Query[T]) Explain() (string, error) {
+	statementSQL, values, err := query.ToSQL()
+	if err != nil {
+		return "", err
+	}
+
+	plan, err := query.cqlQuery.Explain(statementSQL, values)
+	if err != nil {
+		return "", methodError(err, "Explain")
+	}
+
+	return plan, nil
+}
+
+// Iter returns an iterator over the models matching given conditions.
+// Models are scanned one at a time, so they are not all loaded in memory at the same time.
+//
+// Preloads done via join conditions are applied,
+// while collection preloads return ErrCollectionPreloadsNotAllowed
+//
+// Example:
+//
+//	for sale, err := range cql.Query[models.Sale](ctx, db).Iter() {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (query *Query[T]) Iter() iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		if query.err != nil {
+			yield(nil, query.err)
+			return
+		}
+
+		err := query.cqlQuery.Iterate(func(scan func(dest any) error) bool {
+			model := new(T)
+
+			err := scan(model)
+			if err != nil {
+				yield(nil, err)
+				return false
+			}
+
+			return yield(model, nil)
+		})
+		if err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// Each calls forEach for each model matching given conditions,
+// scanning them one at a time (see Iter).
+//
+// The iteration stops at the first error returned by forEach, which is returned by Each
+func (query *Query[T]) Each(forEach func(*T) error) error {
+	for model, err := range query.Iter() {
+		if err != nil {
+			return err
+		}
+
+		err = forEach(model)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindInBatches finds all models matching given conditions in batches of batchSize models,
+// calling forEach with each batch and its number (starting from 1).
+// The iteration stops at the first error returned by forEach, which is returned by FindInBatches.
+//
+// Batches are obtained using keyset pagination (instead of offset) over
+// the fields used in Ascending and Descending (if any) and the primary key of the model,
+// so only fields of the queried model can be used in the order
+// and Offset can not be used (ErrKeysetOffsetNotAllowed is returned).
+//
+// If batchSize is not greater than zero, ErrInvalidBatchSize is returned.
+//
+// Warning: models with null values in the ordering fields will be skipped
+func (query *Query[T]) FindInBatches(batchSize int, forEach func(models []*T, batchNumber int) error) error {
+	if query.err != nil {
+		return query.err
+	}
+
+	if batchSize <= 0 {
+		return methodError(ErrInvalidBatchSize, "FindInBatches")
+	}
+
+	keysetQuery, fields, err := query.keysetFields()
+	if err != nil {
+		return methodError(err, "FindInBatches")
+	}
+
+	var lastValues []any
+
+	for batchNumber := 1; ; batchNumber++ {
+		var models []*T
+
+		err = keysetQuery.findKeyset(&models, fields, lastValues, batchSize, false)
+		if err != nil {
+			return err
+		}
+
+		if len(models) == 0 {
+			return nil
+		}
+
+		err = forEach(models, batchNumber)
+		if err != nil {
+			return err
+		}
+
+		if len(models) < batchSize {
+			return nil
+		}
+
+		lastValues = keysetValues(query.cqlQuery.gormDB.Statement.Context, fields, models[len(models)-1])
+	}
+}
+
+// Paginate returns a page of pageSize models matching given conditions.
+//
+// token must be empty to get the first page or
+// one of the tokens (NextToken or PrevToken) returned in a previous page.
+// Tokens produced for a different order are rejected with ErrPageTokenOrderMismatch.
+//
+// Pages are obtained using keyset pagination (instead of offset) over
+// the fields used in Ascending and Descending (if any) and the primary key of the model,
+// so only fields of the queried model can be used in the order
+// and Offset can not be used (ErrKeysetOffsetNotAllowed is returned).
+//
+// If pageSize is not greater than zero, ErrInvalidBatchSize is returned.
+//
+// Warning: models with null values in the ordering fields will be skipped
+func (query *Query[T]) Paginate(pageSize int, token string) (*Page[T], error) {
+	if query.err != nil {
+		return nil, query.err
+	}
+
+	if pageSize <= 0 {
+		return nil, methodError(ErrInvalidBatchSize, "Paginate")
+	}
+
+	keysetQuery, fields, err := query.keysetFields()
+	if err != nil {
+		return nil, methodError(err, "Paginate")
+	}
+
+	var (
+		values    []any
+		backwards bool
+	)
+
+	if token != "" {
+		values, backwards, err = decodePageToken(fields, token)
+		if err != nil {
+			return nil, methodError(err, "Paginate")
+		}
+	}
+
+	var models []*T
+
+	// one more model is obtained to know if there are more pages
+	err = keysetQuery.findKeyset(&models, fields, values, pageSize+1, backwards)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(models) > pageSize
+	if hasMore {
+		models = models[:pageSize]
+	}
+
+	hasNext, hasPrev := hasMore, token != ""
+
+	if backwards {
+		models = pie.Reverse(models) // models are obtained in the inverse order
+
+		hasNext, hasPrev = token != "", hasMore
+	}
+
+	page := &Page[T]{Items: models}
+
+	if len(models) == 0 {
+		return page, nil
+	}
+
+	ctx := query.cqlQuery.gormDB.Statement.Context
+
+	if hasNext {
+		page.NextToken, err = encodePageToken(fields, keysetValues(ctx, fields, models[len(models)-1]), false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if hasPrev {
+		page.PrevToken, err = encodePageToken(fields, keysetValues(ctx, fields, models[0]), true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// Returns the query and the fields to be used in a keyset pagination
+func (query *Query[T]) keysetFields() (*CQLQuery, []keysetField, error) {
+	modelSchema, err := getSchema(query.cqlQuery.gormDB, *new(T))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	primaryKeys := make([]IField, 0, len(modelSchema.PrimaryFields))
+	for _, primaryField := range modelSchema.PrimaryFields {
+		primaryKeys = append(primaryKeys, NewField[T, any](primaryField.Name, primaryField.DBName, ""))
+	}
+
+	keysetQuery := query.cqlQuery.keysetCopy()
+
+	fields, err := keysetQuery.keysetFields(primaryKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return keysetQuery, fields, nil
+}
+
 func (query *Query[T]) addError(err error) {
 	if err != nil && query.err == nil {
 		query.err = err
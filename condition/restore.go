@@ -0,0 +1,49 @@
+package condition
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Restore of soft deleted models, setting their deleted at column to null
+type Restore[T model.Model] struct {
+	update *Update[T]
+	set    *Set[T]
+}
+
+// Exec restores the soft deleted models that match the conditions,
+// returning the amount of models restored
+func (restore *Restore[T]) Exec() (int64, error) {
+	return restore.update.unsafeSet([]ISet{restore.set}, "Exec")
+}
+
+// ToSQL returns the sql and values of the statement that Exec would execute, without executing it
+func (restore *Restore[T]) ToSQL() (string, []any, error) {
+	return restore.update.ToSQL(restore.set)
+}
+
+// Create a Restore to which the conditions are applied inside transaction tx
+func NewRestore[T model.Model](tx *gorm.DB, conditions []Condition[T]) *Restore[T] {
+	softDeleteColumnName := (*new(T)).SoftDeleteColumnName()
+
+	update := NewUpdate(tx, conditions)
+	if len(conditions) == 0 {
+		update.query.err = methodError(ErrEmptyConditions, "Restore")
+	}
+
+	if update.query.err == nil {
+		err := update.query.cqlQuery.OnlyDeleted(softDeleteColumnName)
+		if err != nil {
+			update.query.addError(methodError(err, "Restore"))
+		}
+	}
+
+	return &Restore[T]{
+		update: update,
+		set: &Set[T]{
+			field: NewField[T, any](deletedAtField, softDeleteColumnName, ""),
+			value: nil,
+		},
+	}
+}
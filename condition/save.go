@@ -0,0 +1,225 @@
+package condition
+
+import (
+	"context"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Option that modifies the behavior of Save
+type SaveOption func(options *saveOptions)
+
+type saveOptions struct {
+	cascade bool
+}
+
+// Cascade makes Save to also persist the changes made to the loaded relations of the model
+// (the ones obtained using preload conditions), recursively
+func Cascade() SaveOption {
+	return func(options *saveOptions) {
+		options.cascade = true
+	}
+}
+
+// Save persists entity inside transaction tx.
+//
+// If entity is not loaded (its primary key is empty), it is inserted.
+// In other case, an UPDATE of all its fields is executed, also setting its updated at column.
+// Every field is written, even the ones that did not change
+// (use SaveTracked to only update the fields that changed since it was loaded).
+//
+// If entity uses optimistic locking (embeds model.Versioned), its version is also verified,
+// so ErrStaleObject is returned if it was modified since it was loaded
+func Save[T model.Model](tx *gorm.DB, entity *T, options ...SaveOption) error {
+	_, err := save(tx, entity, nil, options, "Save")
+
+	return err
+}
+
+// SaveTracked persists the changes made to tracked.Model inside transaction tx,
+// executing an UPDATE of only the fields that changed since it was loaded
+// (or since the last time it was saved), also setting its updated at column.
+//
+// If the model uses optimistic locking (embeds model.Versioned), its version is also verified,
+// so ErrStaleObject is returned if it was modified since it was loaded
+func SaveTracked[T model.Model](tx *gorm.DB, tracked *Tracked[T], options ...SaveOption) error {
+	modelSchema, err := save(tx, tracked.Model, tracked.snapshot, options, "SaveTracked")
+	if err != nil {
+		return err
+	}
+
+	// following saves only persist the changes made after this one
+	*tracked = *newTracked(tx, modelSchema, tracked.Model)
+
+	return nil
+}
+
+// Persists entity, updating only the fields that are different from entitySnapshot
+// (or all of them if entitySnapshot is nil)
+func save[T model.Model](
+	tx *gorm.DB,
+	entity *T,
+	entitySnapshot snapshot,
+	options []SaveOption,
+	method string,
+) (*schema.Schema, error) {
+	saveOptions := saveOptions{}
+	for _, option := range options {
+		option(&saveOptions)
+	}
+
+	modelSchema, err := getSchema(tx, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	if !(*entity).IsLoaded() {
+		create := tx
+		if !saveOptions.cascade {
+			create = tx.Omit(clause.Associations)
+		}
+
+		return modelSchema, create.Create(entity).Error
+	}
+
+	ctx := tx.Statement.Context
+
+	saveGraph := func(tx *gorm.DB) error {
+		return walkLoadedGraph(
+			tx, modelSchema, reflect.ValueOf(entity).Elem(), saveOptions.cascade,
+			func(modelSchema *schema.Schema, modelValue reflect.Value) error {
+				var previousValues map[string]any
+				if entitySnapshot != nil {
+					previousValues = entitySnapshot[modelKey(ctx, modelSchema, modelValue)]
+				}
+
+				return saveModel(ctx, tx, modelSchema, modelValue, previousValues)
+			},
+		)
+	}
+
+	if saveOptions.cascade {
+		// all the models are saved or none of them
+		err = tx.Transaction(saveGraph)
+	} else {
+		err = saveGraph(tx)
+	}
+
+	if err != nil {
+		return nil, methodError(err, method)
+	}
+
+	return modelSchema, nil
+}
+
+// Executes an UPDATE of the columns of the model that are different from previousValues
+// (or of all of them if previousValues is nil)
+func saveModel(
+	ctx context.Context,
+	tx *gorm.DB,
+	modelSchema *schema.Schema,
+	modelValue reflect.Value,
+	previousValues map[string]any,
+) error {
+	entity := modelValue.Interface().(model.Model) //nolint:forcetypeassert // only models are visited
+
+	var versionField *schema.Field
+	if versionColumn := versionColumnName(entity); versionColumn != "" {
+		versionField = modelSchema.LookUpField(versionColumn)
+	}
+
+	updatedAtColumn := entity.UpdatedAtColumnName()
+
+	changes := changedColumns(ctx, modelSchema, modelValue, previousValues, versionField, updatedAtColumn)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if updatedAtField := modelSchema.LookUpField(updatedAtColumn); updatedAtField != nil {
+		now := tx.NowFunc()
+
+		err := updatedAtField.Set(ctx, modelValue, now)
+		if err != nil {
+			return err
+		}
+
+		changes[updatedAtField.DBName] = now
+	}
+
+	update := tx.Session(&gorm.Session{NewDB: true}).Table(modelSchema.Table)
+
+	for _, primaryField := range modelSchema.PrimaryFields {
+		update = update.Where(fieldEquals(ctx, primaryField, modelValue))
+	}
+
+	if versionField != nil {
+		update = update.Where(fieldEquals(ctx, versionField, modelValue))
+		changes[versionField.DBName] = clause.Expr{
+			SQL:  "? + 1",
+			Vars: []any{clause.Column{Name: versionField.DBName}},
+		}
+	}
+
+	result := update.UpdateColumns(changes)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if versionField == nil {
+		return nil
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrStaleObject
+	}
+
+	return incrementVersion(ctx, versionField, modelValue)
+}
+
+// Returns the values of the columns of the model that are different from previousValues
+// (or of all of them if previousValues is nil),
+// excluding the primary key, the version and the updated at columns, that are managed by Save
+func changedColumns(
+	ctx context.Context,
+	modelSchema *schema.Schema,
+	modelValue reflect.Value,
+	previousValues map[string]any,
+	versionField *schema.Field,
+	updatedAtColumn string,
+) map[string]any {
+	changes := map[string]any{}
+
+	for _, field := range modelSchema.Fields {
+		if field.DBName == "" || field.PrimaryKey || !field.Updatable ||
+			field == versionField || field.DBName == updatedAtColumn {
+			continue
+		}
+
+		value, _ := field.ValueOf(ctx, modelValue)
+
+		previousValue, wasLoaded := previousValues[field.DBName]
+		if wasLoaded && reflect.DeepEqual(previousValue, copyValue(reflect.ValueOf(value))) {
+			continue
+		}
+
+		changes[field.DBName] = value
+	}
+
+	return changes
+}
+
+// Returns an expression that verifies that the value of the field in the database
+// is equal to the value of the field in modelValue
+func fieldEquals(ctx context.Context, field *schema.Field, modelValue reflect.Value) clause.Eq {
+	value, _ := field.ValueOf(ctx, modelValue)
+
+	return clause.Eq{
+		Column: clause.Column{Name: field.DBName},
+		Value:  value,
+	}
+}
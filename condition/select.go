@@ -1,7 +1,11 @@
 package condition
 
 import (
+	"strconv"
 	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Selection[T any] interface {
@@ -15,12 +19,21 @@ type IQuery interface {
 	getCQLQuery() *CQLQuery
 }
 
-func Select[TResults any](
+// Query that selects values into a list of TResults
+type SelectQuery[TResults any] struct {
+	gormDB     *gorm.DB
+	selections []Selection[TResults]
+	// amount of columns selected that are not part of the selections
+	extraColumns int
+	err          error
+}
+
+func NewSelectQuery[TResults any](
 	query IQuery,
 	selections []Selection[TResults],
-) ([]TResults, error) {
+) *SelectQuery[TResults] {
 	if query.getError() != nil {
-		return nil, query.getError()
+		return &SelectQuery[TResults]{err: query.getError()}
 	}
 
 	selectSQLs := make([]string, 0, len(selections))
@@ -32,30 +45,167 @@ func Select[TResults any](
 	for _, selection := range selections {
 		sql, values, err := selection.ToSQL(cqlQuery)
 		if err != nil {
-			return nil, err
+			return &SelectQuery[TResults]{err: err}
 		}
 
 		selectSQLs = append(selectSQLs, sql)
 		allValues = append(allValues, values...)
 	}
 
-	var extraCols []any
+	extraColumns := 0
 
 	// add selects that where already in the query, for example for the order
 	if cqlQuery.selectClause.SQL != "" {
 		selectSQLs = append(selectSQLs, cqlQuery.selectClause.SQL)
 		allValues = append(allValues, cqlQuery.selectClause.Vars...)
 
-		for range len(strings.Split(cqlQuery.selectClause.SQL, ",")) {
-			var anything any
-			extraCols = append(extraCols, &anything)
+		extraColumns = len(strings.Split(cqlQuery.selectClause.SQL, ","))
+	}
+
+	return &SelectQuery[TResults]{
+		gormDB: cqlQuery.gormDB.Select(
+			strings.Join(selectSQLs, ", "),
+			allValues...,
+		),
+		selections:   selections,
+		extraColumns: extraColumns,
+	}
+}
+
+// Union combines the results of the query with the ones of other, removing duplicates
+//
+// Order and limit can be applied to the resulting query but not to the combined queries
+// (ErrSetOperationOrderOrLimit is returned), which must select the same number of values.
+func (query *SelectQuery[TResults]) Union(other *SelectQuery[TResults]) *SelectQuery[TResults] {
+	return query.setOperation(union, other)
+}
+
+// UnionAll combines the results of the query with the ones of other, without removing duplicates
+//
+// Order and limit can be applied to the resulting query but not to the combined queries
+// (ErrSetOperationOrderOrLimit is returned), which must select the same number of values.
+func (query *SelectQuery[TResults]) UnionAll(other *SelectQuery[TResults]) *SelectQuery[TResults] {
+	return query.setOperation(unionAll, other)
+}
+
+// Intersect returns the results of the query that are also returned by other
+//
+// Order and limit can be applied to the resulting query but not to the combined queries
+// (ErrSetOperationOrderOrLimit is returned), which must select the same number of values.
+//
+// Available for: postgres, sqlite, sqlserver, mysql (>= 8.0.31)
+func (query *SelectQuery[TResults]) Intersect(other *SelectQuery[TResults]) *SelectQuery[TResults] {
+	return query.setOperation(intersect, other)
+}
+
+// Except returns the results of the query that are not returned by other
+//
+// Order and limit can be applied to the resulting query but not to the combined queries
+// (ErrSetOperationOrderOrLimit is returned), which must select the same number of values.
+//
+// Available for: postgres, sqlite, sqlserver, mysql (>= 8.0.31)
+func (query *SelectQuery[TResults]) Except(other *SelectQuery[TResults]) *SelectQuery[TResults] {
+	return query.setOperation(except, other)
+}
+
+func (query *SelectQuery[TResults]) setOperation(operation setOperation, other *SelectQuery[TResults]) *SelectQuery[TResults] {
+	if query.err != nil {
+		return query
+	}
+
+	if other.err != nil {
+		return other
+	}
+
+	if len(query.selections)+query.extraColumns != len(other.selections)+other.extraColumns {
+		return &SelectQuery[TResults]{err: setOperationError(ErrSetOperationColumnMismatch, operation)}
+	}
+
+	tx := query.gormDB.Session(&gorm.Session{NewDB: true})
+
+	operationSQL, values, err := operation.toSQL(tx, query.gormDB, other.gormDB)
+	if err != nil {
+		return &SelectQuery[TResults]{err: err}
+	}
+
+	return &SelectQuery[TResults]{
+		gormDB:       tx.Table("("+operationSQL+") AS cql_results", values...).Select("*"),
+		selections:   query.selections,
+		extraColumns: query.extraColumns,
+	}
+}
+
+// Ascending specify an ascending order of the results by the selection in the position selectionIndex (starting from 0)
+func (query *SelectQuery[TResults]) Ascending(selectionIndex uint) *SelectQuery[TResults] {
+	return query.order(selectionIndex, false)
+}
+
+// Descending specify a descending order of the results by the selection in the position selectionIndex (starting from 0)
+func (query *SelectQuery[TResults]) Descending(selectionIndex uint) *SelectQuery[TResults] {
+	return query.order(selectionIndex, true)
+}
+
+func (query *SelectQuery[TResults]) order(selectionIndex uint, descending bool) *SelectQuery[TResults] {
+	if query.err != nil {
+		return query
+	}
+
+	if int(selectionIndex) >= len(query.selections) {
+		methodName := "Ascending"
+		if descending {
+			methodName = "Descending"
 		}
+
+		query.err = methodError(ErrSelectionOutOfRange, methodName)
+
+		return query
+	}
+
+	query.gormDB = query.gormDB.Order(clause.OrderByColumn{
+		// columns are referenced by its position in the select, starting from 1
+		Column: clause.Column{Name: strconv.FormatUint(uint64(selectionIndex)+1, 10), Raw: true},
+		Desc:   descending,
+	})
+
+	return query
+}
+
+// Limit specify the number of results to be retrieved
+func (query *SelectQuery[TResults]) Limit(limit int) *SelectQuery[TResults] {
+	if query.err == nil {
+		query.gormDB = query.gormDB.Limit(limit)
+	}
+
+	return query
+}
+
+// Offset specify the number of results to skip before starting to return the results
+//
+// Warning: in MySQL Offset can only be used if Limit is also used
+func (query *SelectQuery[TResults]) Offset(offset int) *SelectQuery[TResults] {
+	if query.err == nil {
+		query.gormDB = query.gormDB.Offset(offset)
 	}
 
-	rows, err := cqlQuery.gormDB.Select(
-		strings.Join(selectSQLs, ", "),
-		allValues...,
-	).Rows()
+	return query
+}
+
+// ToSQL returns the sql and values of the statement that Find would execute, without executing it
+func (query *SelectQuery[TResults]) ToSQL() (string, []any, error) {
+	if query.err != nil {
+		return "", nil, query.err
+	}
+
+	return statementSQL(dryRunSession(query.gormDB).Find(&[]map[string]any{}))
+}
+
+// Find executes the query, returning the list of results
+func (query *SelectQuery[TResults]) Find() ([]TResults, error) {
+	if query.err != nil {
+		return nil, query.err
+	}
+
+	rows, err := query.gormDB.Rows()
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +213,16 @@ func Select[TResults any](
 
 	var results []TResults
 
-	cols := make([]any, 0, len(selections)+len(extraCols))
+	cols := make([]any, 0, len(query.selections)+query.extraColumns)
 
-	for _, selection := range selections {
+	for _, selection := range query.selections {
 		cols = append(cols, selection.ValueType())
 	}
 
-	cols = append(cols, extraCols...)
+	for range query.extraColumns {
+		var anything any
+		cols = append(cols, &anything)
+	}
 
 	for rows.Next() {
 		err = rows.Scan(cols...)
@@ -79,7 +232,7 @@ func Select[TResults any](
 
 		var result TResults
 
-		for i, selection := range selections {
+		for i, selection := range query.selections {
 			err = selection.Apply(cols[i], &result)
 			if err != nil {
 				return nil, err
@@ -95,3 +248,10 @@ func Select[TResults any](
 
 	return results, nil
 }
+
+func Select[TResults any](
+	query IQuery,
+	selections []Selection[TResults],
+) ([]TResults, error) {
+	return NewSelectQuery(query, selections).Find()
+}
@@ -0,0 +1,98 @@
+package condition
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// Operation that combines the results of two queries
+type setOperation struct {
+	sql  string
+	name string
+}
+
+var (
+	union     = setOperation{sql: "UNION", name: "Union"}
+	unionAll  = setOperation{sql: "UNION ALL", name: "UnionAll"}
+	intersect = setOperation{sql: "INTERSECT", name: "Intersect"}
+	except    = setOperation{sql: "EXCEPT", name: "Except"}
+)
+
+// mysql supports INTERSECT and EXCEPT since 8.0.31 (MariaDB since 10.3)
+var mysqlIntersectExceptVersion = []int{8, 0, 31}
+
+func (operation setOperation) supports(db *gorm.DB) bool {
+	if operation != intersect && operation != except {
+		return true
+	}
+
+	if sql.Dialector(db.Dialector.Name()) != sql.MySQL {
+		return true
+	}
+
+	return !mysqlVersionLessThan(db, mysqlIntersectExceptVersion)
+}
+
+// Generates the sql that combines the operands using the operation
+func (operation setOperation) toSQL(db *gorm.DB, operands ...*gorm.DB) (string, []any, error) {
+	if !operation.supports(db) {
+		return "", nil, setOperationError(ErrUnsupportedByDatabase, operation)
+	}
+
+	operandsSQLs := make([]string, 0, len(operands))
+	values := make([]any, 0, len(operands))
+
+	for _, operand := range operands {
+		if hasOrderOrLimit(operand) {
+			return "", nil, setOperationError(ErrSetOperationOrderOrLimit, operation)
+		}
+
+		operandsSQLs = append(operandsSQLs, "?")
+		values = append(values, operand)
+	}
+
+	return strings.Join(operandsSQLs, " "+operation.sql+" "), values, nil
+}
+
+// Returns true if order, limit or offset are applied to the query
+func hasOrderOrLimit(query *gorm.DB) bool {
+	if _, hasOrder := query.Statement.Clauses["ORDER BY"]; hasOrder {
+		return true
+	}
+
+	limitClause, isLimit := query.Statement.Clauses["LIMIT"].Expression.(clause.Limit)
+
+	return isLimit && ((limitClause.Limit != nil && *limitClause.Limit >= 0) || limitClause.Offset > 0)
+}
+
+// Creates a Query that obtains the models that result of the operation between query and other
+func newSetOperationQuery[T model.Model](operation setOperation, query, other *Query[T]) *Query[T] {
+	if query.err != nil {
+		return &Query[T]{err: query.err}
+	}
+
+	if other.err != nil {
+		return &Query[T]{err: other.err}
+	}
+
+	tx := query.cqlQuery.gormDB.Session(&gorm.Session{NewDB: true})
+
+	operationSQL, values, err := operation.toSQL(tx, query.cqlQuery.gormDB, other.cqlQuery.gormDB)
+	if err != nil {
+		return &Query[T]{err: err}
+	}
+
+	tableName, err := getTableName(tx, *new(T))
+	if err != nil {
+		return &Query[T]{err: err}
+	}
+
+	// the result of the operation is named as the table of the model
+	// so order and limit can be applied to it
+	return NewQuery[T](tx.Table("("+operationSQL+") AS "+tableName, values...))
+}
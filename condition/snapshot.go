@@ -0,0 +1,218 @@
+package condition
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Values of the columns of each model of a graph (a model and its loaded relations)
+// at the moment it was loaded from the database, indexed by the key of each model (see modelKey)
+type snapshot map[string]map[string]any
+
+// Model loaded from the database that keeps a snapshot of its values
+// (and the ones of its preloaded relations) at the moment it was loaded,
+// so SaveTracked only persists the fields that changed since then
+type Tracked[T model.Model] struct {
+	Model    *T
+	snapshot snapshot
+}
+
+// Returns loaded tracked, taking a snapshot of its current values
+func newTracked[T model.Model](db *gorm.DB, modelSchema *schema.Schema, loaded *T) *Tracked[T] {
+	return &Tracked[T]{
+		Model:    loaded,
+		snapshot: newSnapshot(db, modelSchema, reflect.ValueOf(loaded).Elem()),
+	}
+}
+
+// Returns models tracked, taking a snapshot of the current values of each of them
+func trackAll[T model.Model](db *gorm.DB, models []*T) ([]*Tracked[T], error) {
+	modelSchema, err := getSchema(db, new(T))
+	if err != nil {
+		return nil, err
+	}
+
+	tracked := make([]*Tracked[T], 0, len(models))
+
+	for _, loaded := range models {
+		tracked = append(tracked, newTracked(db, modelSchema, loaded))
+	}
+
+	return tracked, nil
+}
+
+func newSnapshot(db *gorm.DB, modelSchema *schema.Schema, modelValue reflect.Value) snapshot {
+	ctx := db.Statement.Context
+	modelSnapshot := snapshot{}
+
+	// visit never returns error
+	_ = walkLoadedGraph(db, modelSchema, modelValue, true, func(modelSchema *schema.Schema, modelValue reflect.Value) error {
+		modelSnapshot[modelKey(ctx, modelSchema, modelValue)] = columnValues(ctx, modelSchema, modelValue)
+
+		return nil
+	})
+
+	return modelSnapshot
+}
+
+// Calls visit for modelValue and, if cascade is true, for each of its loaded relations (recursively).
+//
+// Each model is visited only once, even if it appears more than once in the graph.
+// The iteration stops at the first error returned by visit, which is returned by walkLoadedGraph
+func walkLoadedGraph(
+	db *gorm.DB,
+	modelSchema *schema.Schema,
+	modelValue reflect.Value,
+	cascade bool,
+	visit func(modelSchema *schema.Schema, modelValue reflect.Value) error,
+) error {
+	ctx := db.Statement.Context
+	visited := map[string]bool{}
+
+	var walk func(modelSchema *schema.Schema, modelValue reflect.Value) error
+
+	walk = func(modelSchema *schema.Schema, modelValue reflect.Value) error {
+		key := modelKey(ctx, modelSchema, modelValue)
+		if visited[key] {
+			return nil
+		}
+
+		visited[key] = true
+
+		err := visit(modelSchema, modelValue)
+		if err != nil || !cascade {
+			return err
+		}
+
+		for _, relation := range sortedRelations(modelSchema) {
+			for _, relatedValue := range loadedRelated(relation.Field.ReflectValueOf(ctx, modelValue)) {
+				// relation.FieldSchema is not used as it can be wrong for relations between models that reference each other
+				var relatedSchema *schema.Schema
+
+				relatedSchema, err = getSchema(db, reflect.New(relatedValue.Type()).Interface())
+				if err != nil {
+					return err
+				}
+
+				err = walk(relatedSchema, relatedValue)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if !isLoaded(modelValue) {
+		return nil
+	}
+
+	return walk(modelSchema, modelValue)
+}
+
+// Returns the relations of the schema ordered by name, so the graph is always walked in the same order
+func sortedRelations(modelSchema *schema.Schema) []*schema.Relationship {
+	relations := make([]*schema.Relationship, 0, len(modelSchema.Relationships.Relations))
+	for _, relation := range modelSchema.Relationships.Relations {
+		relations = append(relations, relation)
+	}
+
+	sort.Slice(relations, func(i, j int) bool {
+		return relations[i].Name < relations[j].Name
+	})
+
+	return relations
+}
+
+// Returns the loaded models contained in the value of a relation
+// (a model, a pointer to a model or a slice of models or pointers to models)
+func loadedRelated(relationValue reflect.Value) []reflect.Value {
+	related := []reflect.Value{}
+
+	switch relationValue.Kind() { //nolint:exhaustive // other kinds can not contain models
+	case reflect.Pointer:
+		if !relationValue.IsNil() {
+			related = append(related, loadedRelated(relationValue.Elem())...)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < relationValue.Len(); i++ {
+			related = append(related, loadedRelated(relationValue.Index(i))...)
+		}
+	case reflect.Struct:
+		if isLoaded(relationValue) {
+			related = append(related, relationValue)
+		}
+	}
+
+	return related
+}
+
+// Returns true if modelValue is a model loaded from the database
+func isLoaded(modelValue reflect.Value) bool {
+	loaded, isModel := modelValue.Interface().(model.Model)
+
+	return isModel && loaded.IsLoaded()
+}
+
+// Returns an identifier of the model: its table and the values of its primary key
+func modelKey(ctx context.Context, modelSchema *schema.Schema, modelValue reflect.Value) string {
+	primaryKeys := make([]string, 0, len(modelSchema.PrimaryFields))
+
+	for _, primaryField := range modelSchema.PrimaryFields {
+		value, _ := primaryField.ValueOf(ctx, modelValue)
+		primaryKeys = append(primaryKeys, fmt.Sprint(value))
+	}
+
+	return modelSchema.Table + ":" + strings.Join(primaryKeys, ",")
+}
+
+// Returns the values of the columns of the model, indexed by column name
+func columnValues(ctx context.Context, modelSchema *schema.Schema, modelValue reflect.Value) map[string]any {
+	values := map[string]any{}
+
+	for _, field := range modelSchema.Fields {
+		if field.DBName == "" {
+			continue
+		}
+
+		value, _ := field.ValueOf(ctx, modelValue)
+		values[field.DBName] = copyValue(reflect.ValueOf(value))
+	}
+
+	return values
+}
+
+// Returns a copy of value that does not share memory with it,
+// so modifications made to value (for example, through a pointer) are not reflected in the copy
+func copyValue(value reflect.Value) any {
+	switch value.Kind() { //nolint:exhaustive // other kinds are copied when assigned
+	case reflect.Invalid:
+		return nil
+	case reflect.Pointer:
+		if value.IsNil() {
+			return nil
+		}
+
+		return copyValue(value.Elem())
+	case reflect.Slice:
+		if value.IsNil() {
+			return nil
+		}
+
+		valueCopy := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		reflect.Copy(valueCopy, value)
+
+		return valueCopy.Interface()
+	}
+
+	return value.Interface()
+}
@@ -0,0 +1,47 @@
+package condition
+
+import (
+	"gorm.io/gorm"
+)
+
+// Value obtained from a subquery that selects a single value
+//
+// It can be used as a scalar value in comparisons (if the subquery returns only one row)
+// or as the list of values of In and NotIn
+type Subquery[T any] struct {
+	query IQuery
+	value ValueOfType[T]
+}
+
+func NewSubquery[T any](query IQuery, value ValueOfType[T]) Subquery[T] {
+	return Subquery[T]{
+		query: query,
+		value: value,
+	}
+}
+
+func (subquery Subquery[T]) GetValue() T {
+	return *new(T)
+}
+
+func (subquery Subquery[T]) ToSQL(_ *CQLQuery) (string, []any, error) {
+	if subquery.query.getError() != nil {
+		return "", nil, subquery.query.getError()
+	}
+
+	cqlQuery := subquery.query.getCQLQuery()
+
+	valueSQL, values, err := subquery.value.ToSQL(cqlQuery)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return "(?)", []any{
+		cqlQuery.gormDB.Session(&gorm.Session{}).Select(valueSQL, values...),
+	}, nil
+}
+
+func (subquery Subquery[T]) isSubquery() {
+	// This method is used to identify a subquery
+	// when it is used as the list of values of In and NotIn
+}
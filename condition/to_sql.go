@@ -0,0 +1,125 @@
+package condition
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// Returns a session of db in which the statements are generated but not executed
+func dryRunSession(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{
+		DryRun:                 true,
+		SkipDefaultTransaction: true,
+		Initialized:            true,
+	})
+}
+
+// Returns the sql and values of the statement generated by tx
+func statementSQL(tx *gorm.DB) (string, []any, error) {
+	if tx.Error != nil {
+		return "", nil, tx.Error
+	}
+
+	return tx.Statement.SQL.String(), tx.Statement.Vars, nil
+}
+
+// ToSQL returns the sql and values of the statement generated by execute, without executing it
+func (query *CQLQuery) ToSQL(execute func() (*gorm.DB, error)) (string, []any, error) {
+	originalDB := query.gormDB
+
+	defer func() {
+		query.gormDB = originalDB
+	}()
+
+	query.gormDB = dryRunSession(query.gormDB)
+
+	tx, err := execute()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return statementSQL(tx)
+}
+
+// Explain returns the execution plan that the database will use to execute the statement (sql with values)
+//
+// Available for: postgres, mysql, sqlite
+func (query *CQLQuery) Explain(statementSQL string, values []any) (string, error) {
+	var explain string
+
+	switch query.Dialector() {
+	case sql.Postgres:
+		explain = "EXPLAIN ANALYZE "
+	case sql.MySQL:
+		explain = "EXPLAIN "
+	case sql.SQLite:
+		explain = "EXPLAIN QUERY PLAN "
+	case sql.SQLServer:
+		return "", ErrUnsupportedByDatabase
+	}
+
+	rows, err := query.gormDB.Statement.ConnPool.QueryContext(
+		query.gormDB.Statement.Context,
+		explain+statementSQL,
+		values...,
+	)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", err
+	}
+
+	lines := []string{}
+
+	// plans with more than one column are returned as a table
+	if len(columns) > 1 {
+		lines = append(lines, strings.Join(columns, "\t"))
+	}
+
+	for rows.Next() {
+		rowValues := make([]any, len(columns))
+		rowPointers := make([]any, len(columns))
+
+		for i := range rowValues {
+			rowPointers[i] = &rowValues[i]
+		}
+
+		err = rows.Scan(rowPointers...)
+		if err != nil {
+			return "", err
+		}
+
+		rowStrings := make([]string, 0, len(columns))
+
+		for _, value := range rowValues {
+			rowStrings = append(rowStrings, explainValueToString(value))
+		}
+
+		lines = append(lines, strings.Join(rowStrings, "\t"))
+	}
+
+	if rows.Err() != nil {
+		return "", rows.Err()
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+func explainValueToString(value any) string {
+	switch typedValue := value.(type) {
+	case nil:
+		return "NULL"
+	case []byte:
+		return string(typedValue)
+	default:
+		return fmt.Sprint(typedValue)
+	}
+}
@@ -0,0 +1,265 @@
+package condition
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// Tuple of two values (in general, fields of the model or of joined models)
+// that can be compared with lists of tuples of values of the same types
+type Tuple2[TObject model.Model, T1, T2 any] struct {
+	values []IValue
+}
+
+// NewTuple2 creates a Tuple2 of value1 and value2
+func NewTuple2[TObject model.Model, T1, T2 any](value1 ValueOfType[T1], value2 ValueOfType[T2]) Tuple2[TObject, T1, T2] {
+	return Tuple2[TObject, T1, T2]{
+		values: []IValue{value1, value2},
+	}
+}
+
+// In verifies that the tuple is equal to one of the tuples of values
+func (tuple Tuple2[TObject, T1, T2]) In(values TupleValues2[T1, T2], others ...TupleValues2[T1, T2]) WhereCondition[TObject] {
+	return newTupleCondition[TObject](tuple.values, tupleValuesList(values, others), false)
+}
+
+// NotIn verifies that the tuple is not equal to any of the tuples of values
+func (tuple Tuple2[TObject, T1, T2]) NotIn(values TupleValues2[T1, T2], others ...TupleValues2[T1, T2]) WhereCondition[TObject] {
+	return newTupleCondition[TObject](tuple.values, tupleValuesList(values, others), true)
+}
+
+// Tuple of values that can be compared with a Tuple2
+type TupleValues2[T1, T2 any] struct {
+	values []IValue
+}
+
+// NewTupleValues2 creates a TupleValues2 of value1 and value2
+func NewTupleValues2[T1, T2 any](value1 ValueOfType[T1], value2 ValueOfType[T2]) TupleValues2[T1, T2] {
+	return TupleValues2[T1, T2]{
+		values: []IValue{value1, value2},
+	}
+}
+
+func (tupleValues TupleValues2[T1, T2]) getValues() []IValue {
+	return tupleValues.values
+}
+
+// Tuple of three values (in general, fields of the model or of joined models)
+// that can be compared with lists of tuples of values of the same types
+type Tuple3[TObject model.Model, T1, T2, T3 any] struct {
+	values []IValue
+}
+
+// NewTuple3 creates a Tuple3 of value1, value2 and value3
+func NewTuple3[TObject model.Model, T1, T2, T3 any](
+	value1 ValueOfType[T1],
+	value2 ValueOfType[T2],
+	value3 ValueOfType[T3],
+) Tuple3[TObject, T1, T2, T3] {
+	return Tuple3[TObject, T1, T2, T3]{
+		values: []IValue{value1, value2, value3},
+	}
+}
+
+// In verifies that the tuple is equal to one of the tuples of values
+func (tuple Tuple3[TObject, T1, T2, T3]) In(
+	values TupleValues3[T1, T2, T3],
+	others ...TupleValues3[T1, T2, T3],
+) WhereCondition[TObject] {
+	return newTupleCondition[TObject](tuple.values, tupleValuesList(values, others), false)
+}
+
+// NotIn verifies that the tuple is not equal to any of the tuples of values
+func (tuple Tuple3[TObject, T1, T2, T3]) NotIn(
+	values TupleValues3[T1, T2, T3],
+	others ...TupleValues3[T1, T2, T3],
+) WhereCondition[TObject] {
+	return newTupleCondition[TObject](tuple.values, tupleValuesList(values, others), true)
+}
+
+// Tuple of values that can be compared with a Tuple3
+type TupleValues3[T1, T2, T3 any] struct {
+	values []IValue
+}
+
+// NewTupleValues3 creates a TupleValues3 of value1, value2 and value3
+func NewTupleValues3[T1, T2, T3 any](
+	value1 ValueOfType[T1],
+	value2 ValueOfType[T2],
+	value3 ValueOfType[T3],
+) TupleValues3[T1, T2, T3] {
+	return TupleValues3[T1, T2, T3]{
+		values: []IValue{value1, value2, value3},
+	}
+}
+
+func (tupleValues TupleValues3[T1, T2, T3]) getValues() []IValue {
+	return tupleValues.values
+}
+
+type tupleValues interface {
+	getValues() []IValue
+}
+
+func tupleValuesList[TValues tupleValues](values TValues, others []TValues) [][]IValue {
+	list := make([][]IValue, 0, len(others)+1)
+	list = append(list, values.getValues())
+
+	for _, other := range others {
+		list = append(list, other.getValues())
+	}
+
+	return list
+}
+
+// Condition that verifies that a tuple of values is (or is not) in a list of tuples of values
+type tupleCondition[TObject model.Model] struct {
+	values     []IValue
+	valuesList [][]IValue
+	not        bool
+}
+
+func newTupleCondition[TObject model.Model](values []IValue, valuesList [][]IValue, not bool) WhereCondition[TObject] {
+	return tupleCondition[TObject]{
+		values:     values,
+		valuesList: valuesList,
+		not:        not,
+	}
+}
+
+func (condition tupleCondition[TObject]) interfaceVerificationMethod(_ TObject) {
+	// This method is necessary to get the compiler to verify
+	// that an object is of type Condition[T]
+}
+
+func (condition tupleCondition[TObject]) applyTo(query *CQLQuery, table Table) error {
+	return ApplyWhereCondition[TObject](condition, query, table)
+}
+
+func (condition tupleCondition[TObject]) affectsDeletedAt() bool {
+	for _, value := range condition.values {
+		if field, isField := value.(IField); isField && field.fieldName() == deletedAtField {
+			return true
+		}
+	}
+
+	return false
+}
+
+// (value1, value2) IN ((?, ?), (?, ?))
+//
+// or, as sqlserver does not support row values, ((value1 = ? AND value2 = ?) OR (value1 = ? AND value2 = ?))
+func (condition tupleCondition[TObject]) getSQL(query *CQLQuery, table Table) (string, []any, error) {
+	valuesSQLs := make([]string, 0, len(condition.values))
+	valuesValues := make([][]any, 0, len(condition.values))
+
+	for _, value := range condition.values {
+		valueSQL, values, err := tupleValueToSQL[TObject](query, table, value)
+		if err != nil {
+			return "", nil, err
+		}
+
+		valuesSQLs = append(valuesSQLs, valueSQL)
+		valuesValues = append(valuesValues, values)
+	}
+
+	if query.Dialector() == sql.SQLServer {
+		return condition.orOfAnds(query, table, valuesSQLs, valuesValues)
+	}
+
+	allValues := []any{}
+	for _, values := range valuesValues {
+		allValues = append(allValues, values...)
+	}
+
+	tuplesSQLs := make([]string, 0, len(condition.valuesList))
+
+	for _, tuple := range condition.valuesList {
+		tupleSQLs := make([]string, 0, len(tuple))
+
+		for _, value := range tuple {
+			valueSQL, values, err := tupleValueToSQL[TObject](query, table, value)
+			if err != nil {
+				return "", nil, err
+			}
+
+			tupleSQLs = append(tupleSQLs, valueSQL)
+			allValues = append(allValues, values...)
+		}
+
+		tuplesSQLs = append(tuplesSQLs, "("+strings.Join(tupleSQLs, ", ")+")")
+	}
+
+	operator := " IN "
+	if condition.not {
+		operator = " NOT IN "
+	}
+
+	return "(" + strings.Join(valuesSQLs, ", ") + ")" + operator + "(" + strings.Join(tuplesSQLs, ", ") + ")",
+		allValues,
+		nil
+}
+
+func (condition tupleCondition[TObject]) orOfAnds(
+	query *CQLQuery,
+	table Table,
+	valuesSQLs []string,
+	valuesValues [][]any,
+) (string, []any, error) {
+	allValues := []any{}
+	tuplesSQLs := make([]string, 0, len(condition.valuesList))
+
+	for _, tuple := range condition.valuesList {
+		equalsSQLs := make([]string, 0, len(tuple))
+
+		for i, value := range tuple {
+			valueSQL, values, err := tupleValueToSQL[TObject](query, table, value)
+			if err != nil {
+				return "", nil, err
+			}
+
+			equalsSQLs = append(equalsSQLs, valuesSQLs[i]+" = "+valueSQL)
+			allValues = append(allValues, valuesValues[i]...)
+			allValues = append(allValues, values...)
+		}
+
+		tuplesSQLs = append(tuplesSQLs, "("+strings.Join(equalsSQLs, " AND ")+")")
+	}
+
+	conditionSQL := "(" + strings.Join(tuplesSQLs, " OR ") + ")"
+	if condition.not {
+		conditionSQL = "NOT " + conditionSQL
+	}
+
+	return conditionSQL, allValues, nil
+}
+
+// Returns the sql of a value of a tuple,
+// using table for the fields of TObject (the model to which the condition is applied)
+func tupleValueToSQL[TObject model.Model](query *CQLQuery, table Table, value IValue) (string, []any, error) {
+	var (
+		valueSQL string
+		values   []any
+		err      error
+	)
+
+	if field, isField := value.(IField); isField && field.getModelType() == reflect.TypeOf(*new(TObject)) {
+		valueSQL, values, err = field.ToSQLForTable(query, table)
+	} else {
+		valueSQL, values, err = value.ToSQL(query)
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if valueSQL == "" {
+		// static value
+		return "?", values, nil
+	}
+
+	return valueSQL, values, nil
+}
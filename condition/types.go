@@ -77,3 +77,11 @@ func Time(value time.Time) Value[time.Time] {
 func UUID(value model.UUID) Value[model.UUID] {
 	return Value[model.UUID]{Value: value}
 }
+
+func ID[TID model.ID](value TID) Value[TID] {
+	return Value[TID]{Value: value}
+}
+
+func JSON(value model.JSON) Value[model.JSON] {
+	return Value[model.JSON]{Value: value}
+}
@@ -9,6 +9,9 @@ import (
 
 type Update[T model.Model] struct {
 	OrderLimitReturning[T]
+
+	// model to be updated when created with NewModelUpdate
+	loaded *loadedModel[T]
 }
 
 // Set allows updating multiple attributes of the same table.
@@ -34,6 +37,29 @@ func (update *Update[T]) SetMultiple(sets ...ISet) (int64, error) {
 	return update.unsafeSet(sets, methodName)
 }
 
+// ToSQL returns the sql and values of the statement that Set would execute with sets, without executing it
+func (update *Update[T]) ToSQL(sets ...*Set[T]) (string, []any, error) {
+	if update.query.err != nil {
+		return "", nil, update.query.err
+	}
+
+	setsAsInterface := []ISet{}
+	for _, set := range sets {
+		setsAsInterface = append(setsAsInterface, set)
+	}
+
+	cqlQuery := update.query.cqlQuery
+
+	statementSQL, values, err := cqlQuery.ToSQL(func() (*gorm.DB, error) {
+		return cqlQuery.update(setsAsInterface)
+	})
+	if err != nil {
+		return "", nil, methodError(err, "ToSQL")
+	}
+
+	return statementSQL, values, nil
+}
+
 func (update *Update[T]) unsafeSet(sets []ISet, methodName string) (int64, error) {
 	if update.query.err != nil {
 		return 0, update.query.err
@@ -44,6 +70,18 @@ func (update *Update[T]) unsafeSet(sets []ISet, methodName string) (int64, error
 		return 0, methodError(err, methodName)
 	}
 
+	if update.loaded != nil {
+		err = update.loaded.verifyAffected(updated)
+		if err != nil {
+			return 0, methodError(err, methodName)
+		}
+
+		err = update.loaded.incrementVersion()
+		if err != nil {
+			return 0, methodError(err, methodName)
+		}
+	}
+
 	return updated, nil
 }
 
@@ -106,6 +144,25 @@ func NewUpdate[T model.Model](tx *gorm.DB, conditions []Condition[T]) *Update[T]
 	}
 }
 
+// Create a Update of the model loaded, identified by its primary key, inside transaction tx
+//
+// If the model uses optimistic locking (embeds model.Versioned),
+// its version is also verified, so ErrStaleObject is returned if it was modified since it was loaded
+func NewModelUpdate[T model.Model](tx *gorm.DB, loaded *T) *Update[T] {
+	loadedModel, conditions, err := newLoadedModel(tx, loaded)
+	if err != nil {
+		update := NewUpdate[T](tx, nil)
+		update.query.err = methodError(err, "UpdateModel")
+
+		return update
+	}
+
+	update := NewUpdate(tx, conditions)
+	update.loaded = loadedModel
+
+	return update
+}
+
 type ISet interface {
 	getField() IField
 	getValue() IValue
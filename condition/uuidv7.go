@@ -0,0 +1,53 @@
+package condition
+
+import (
+	"time"
+
+	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// Field of type model.UUID of the model T
+type UUIDFieldOfModel[T model.Model] interface {
+	FieldOfModel[T]
+	ValueOfType[model.UUID]
+}
+
+// UUIDv7Between verifies that the version 7 uuid of field was generated
+// between from and to (both included, with millisecond precision),
+// allowing to filter models by their creation time using the index of their id.
+//
+// Not supported by sqlserver, as uniqueidentifier values are not ordered by their timestamp.
+func UUIDv7Between[TObject model.Model](field UUIDFieldOfModel[TObject], from, to time.Time) WhereCondition[TObject] {
+	return uuidV7BetweenCondition[TObject]{
+		fieldCondition: NewFieldCondition[TObject](
+			field,
+			Between[model.UUID](UUID(model.MinUUIDv7(from)), UUID(model.MaxUUIDv7(to))),
+		),
+	}
+}
+
+type uuidV7BetweenCondition[TObject model.Model] struct {
+	fieldCondition WhereCondition[TObject]
+}
+
+func (condition uuidV7BetweenCondition[TObject]) interfaceVerificationMethod(_ TObject) {
+	// This method is necessary to get the compiler to verify
+	// that an object is of type Condition[T]
+}
+
+func (condition uuidV7BetweenCondition[TObject]) applyTo(query *CQLQuery, table Table) error {
+	return ApplyWhereCondition[TObject](condition, query, table)
+}
+
+func (condition uuidV7BetweenCondition[TObject]) affectsDeletedAt() bool {
+	return false
+}
+
+func (condition uuidV7BetweenCondition[TObject]) getSQL(query *CQLQuery, table Table) (string, []any, error) {
+	if query.Dialector() == sql.SQLServer {
+		return "", nil, methodError(ErrUnsupportedByDatabase, "UUIDv7Between")
+	}
+
+	return condition.fieldCondition.getSQL(query, table)
+}
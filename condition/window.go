@@ -0,0 +1,248 @@
+package condition
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// Function that performs a calculation across a set of rows that are related to the current row.
+//
+// The set of rows (window) is defined using PartitionBy, Ascending, Descending and Rows or Range.
+type WindowFunction[T any] struct {
+	function    IValue
+	partitionBy []IField
+	orderBy     []windowOrder
+	frame       string
+}
+
+type windowOrder struct {
+	field      IField
+	descending bool
+}
+
+func NewWindowFunction[T any](function IValue) WindowFunction[T] {
+	return WindowFunction[T]{
+		function: function,
+	}
+}
+
+func (window WindowFunction[T]) GetValue() T {
+	return *new(T)
+}
+
+// PartitionBy divides the rows into groups (partitions) that share the same values of the fields,
+// the function is applied to each partition independently
+func (window WindowFunction[T]) PartitionBy(fields ...IField) WindowFunction[T] {
+	window.partitionBy = append(append([]IField{}, window.partitionBy...), fields...)
+
+	return window
+}
+
+// Ascending specify an ascending order of the rows inside each partition
+func (window WindowFunction[T]) Ascending(field IField) WindowFunction[T] {
+	return window.order(field, false)
+}
+
+// Descending specify a descending order of the rows inside each partition
+func (window WindowFunction[T]) Descending(field IField) WindowFunction[T] {
+	return window.order(field, true)
+}
+
+func (window WindowFunction[T]) order(field IField, descending bool) WindowFunction[T] {
+	window.orderBy = append(
+		append([]windowOrder{}, window.orderBy...),
+		windowOrder{field: field, descending: descending},
+	)
+
+	return window
+}
+
+// Rows defines the frame of the window (the set of rows of the partition to which the function is applied)
+// as the rows between start and end, counted by physical position
+//
+// Example: Rows(UnboundedPreceding(), CurrentRow())
+func (window WindowFunction[T]) Rows(start, end FrameBound) WindowFunction[T] {
+	window.frame = "ROWS BETWEEN " + start.sql + " AND " + end.sql
+
+	return window
+}
+
+// Range defines the frame of the window (the set of rows of the partition to which the function is applied)
+// as the rows between start and end, counted by the value of the order
+//
+// Warning: in sqlserver only UnboundedPreceding, CurrentRow and UnboundedFollowing are supported
+func (window WindowFunction[T]) Range(start, end FrameBound) WindowFunction[T] {
+	window.frame = "RANGE BETWEEN " + start.sql + " AND " + end.sql
+
+	return window
+}
+
+func (window WindowFunction[T]) ToSQL(query *CQLQuery) (string, []any, error) {
+	functionSQL, values, err := window.function.ToSQL(query)
+	if err != nil {
+		return "", nil, err
+	}
+
+	overSQLs := []string{}
+
+	if len(window.partitionBy) > 0 {
+		partitionSQLs := make([]string, 0, len(window.partitionBy))
+
+		for _, field := range window.partitionBy {
+			fieldSQL, fieldValues, err := field.ToSQL(query)
+			if err != nil {
+				return "", nil, err
+			}
+
+			partitionSQLs = append(partitionSQLs, fieldSQL)
+			values = append(values, fieldValues...)
+		}
+
+		overSQLs = append(overSQLs, "PARTITION BY "+strings.Join(partitionSQLs, ", "))
+	}
+
+	if len(window.orderBy) > 0 {
+		orderSQLs := make([]string, 0, len(window.orderBy))
+
+		for _, order := range window.orderBy {
+			fieldSQL, fieldValues, err := order.field.ToSQL(query)
+			if err != nil {
+				return "", nil, err
+			}
+
+			if order.descending {
+				fieldSQL += " DESC"
+			}
+
+			orderSQLs = append(orderSQLs, fieldSQL)
+			values = append(values, fieldValues...)
+		}
+
+		overSQLs = append(overSQLs, "ORDER BY "+strings.Join(orderSQLs, ", "))
+	}
+
+	if window.frame != "" {
+		overSQLs = append(overSQLs, window.frame)
+	}
+
+	return functionSQL + " OVER (" + strings.Join(overSQLs, " ") + ")", values, nil
+}
+
+// Limit of the frame of a window function
+type FrameBound struct {
+	sql string
+}
+
+// The frame starts with the first row of the partition
+func UnboundedPreceding() FrameBound {
+	return FrameBound{sql: "UNBOUNDED PRECEDING"}
+}
+
+// The frame starts or ends offset rows (or values if Range is used) before the current row
+func Preceding(offset uint) FrameBound {
+	return FrameBound{sql: strconv.FormatUint(uint64(offset), 10) + " PRECEDING"}
+}
+
+// The frame starts or ends with the current row
+func CurrentRow() FrameBound {
+	return FrameBound{sql: "CURRENT ROW"}
+}
+
+// The frame starts or ends offset rows (or values if Range is used) after the current row
+func Following(offset uint) FrameBound {
+	return FrameBound{sql: strconv.FormatUint(uint64(offset), 10) + " FOLLOWING"}
+}
+
+// The frame ends with the last row of the partition
+func UnboundedFollowing() FrameBound {
+	return FrameBound{sql: "UNBOUNDED FOLLOWING"}
+}
+
+// Call to a function that is not applied to a field
+type functionCall struct {
+	function sql.FunctionByDialector
+	values   []IValue
+}
+
+func (call functionCall) ToSQL(query *CQLQuery) (string, []any, error) {
+	function, isPresent := call.function.Get(query.Dialector())
+	if !isPresent {
+		return "", nil, functionError(ErrUnsupportedByDatabase, call.function)
+	}
+
+	valuesSQLs := make([]string, 0, len(call.values))
+	values := []any{}
+
+	for _, value := range call.values {
+		valueSQL, valueValues, err := value.ToSQL(query)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if valueSQL == "" {
+			valueSQL = "?"
+		}
+
+		valuesSQLs = append(valuesSQLs, valueSQL)
+		values = append(values, valueValues...)
+	}
+
+	if len(valuesSQLs) == 0 {
+		return function.ApplyTo("", nil), values, nil
+	}
+
+	return function.ApplyTo(valuesSQLs[0], valuesSQLs[1:]), values, nil
+}
+
+// Value that is written directly in the sql
+type rawValue string
+
+func (value rawValue) ToSQL(_ *CQLQuery) (string, []any, error) {
+	return string(value), nil, nil
+}
+
+// RowNumber returns the number of the current row within its partition, counting from 1
+func RowNumber() WindowFunction[float64] {
+	return NewWindowFunction[float64](functionCall{function: sql.RowNumber})
+}
+
+// Rank returns the rank of the current row within its partition, with gaps
+func Rank() WindowFunction[float64] {
+	return NewWindowFunction[float64](functionCall{function: sql.Rank})
+}
+
+// DenseRank returns the rank of the current row within its partition, without gaps
+func DenseRank() WindowFunction[float64] {
+	return NewWindowFunction[float64](functionCall{function: sql.DenseRank})
+}
+
+// Lag returns value evaluated at the row that is offset rows before the current row within its partition,
+// or defaultValue if there is no such row
+func Lag[T any](value ValueOfType[T], offset uint, defaultValue ValueOfType[T]) WindowFunction[T] {
+	return newOffsetWindowFunction(sql.Lag, value, offset, defaultValue)
+}
+
+// Lead returns value evaluated at the row that is offset rows after the current row within its partition,
+// or defaultValue if there is no such row
+func Lead[T any](value ValueOfType[T], offset uint, defaultValue ValueOfType[T]) WindowFunction[T] {
+	return newOffsetWindowFunction(sql.Lead, value, offset, defaultValue)
+}
+
+func newOffsetWindowFunction[T any](
+	function sql.FunctionByDialector,
+	value ValueOfType[T],
+	offset uint,
+	defaultValue ValueOfType[T],
+) WindowFunction[T] {
+	return NewWindowFunction[T](functionCall{
+		function: function,
+		values: []IValue{
+			value,
+			// offset is written directly as some databases do not allow placeholders for it
+			rawValue(strconv.FormatUint(uint64(offset), 10)),
+			defaultValue,
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"go/types"
 
 	"github.com/dave/jennifer/jen"
+	"github.com/elliotchance/pie/v2"
 	"github.com/ettle/strcase"
 
 	"github.com/FrancoLiberali/cql/cql-gen/cmd/log"
@@ -12,31 +13,50 @@ import (
 // force ci
 const (
 	// cql/condition
-	conditionPath           = cqlPath + "/condition"
-	cqlCondition            = "Condition"
-	cqlJoinCondition        = "JoinCondition"
-	cqlNewJoinCondition     = "NewJoinCondition"
-	cqlNewPreloadCondition  = "NewPreloadCondition"
-	cqlField                = "Field"
-	cqlUpdatableField       = "UpdatableField"
-	cqlNullableField        = "NullableField"
-	cqlBoolField            = "BoolField"
-	cqlNullableBoolField    = "NullableBoolField"
-	cqlStringField          = "StringField"
-	cqlNullableStringField  = "NullableStringField"
-	cqlNumericField         = "NumericField"
-	cqlNullableNumericField = "NullableNumericField"
-	cqlNewField             = "New"
-	cqlCollection           = "Collection"
-	cqlNewCollection        = "NewCollection"
+	conditionPath                = cqlPath + "/condition"
+	cqlCondition                 = "Condition"
+	cqlJoinCondition             = "JoinCondition"
+	cqlNewJoinCondition          = "NewJoinCondition"
+	cqlNewCompositeJoinCondition = "NewCompositeJoinCondition"
+	cqlNewPreloadCondition       = "NewPreloadCondition"
+	cqlField                     = "Field"
+	cqlUpdatableField            = "UpdatableField"
+	cqlNullableField             = "NullableField"
+	cqlBoolField                 = "BoolField"
+	cqlNullableBoolField         = "NullableBoolField"
+	cqlStringField               = "StringField"
+	cqlNullableStringField       = "NullableStringField"
+	cqlNumericField              = "NumericField"
+	cqlNullableNumericField      = "NullableNumericField"
+	cqlNotUpdatableNumericField  = "NotUpdatableNumericField"
+	cqlJSONField                 = "JSONField"
+	cqlNullableJSONField         = "NullableJSONField"
+	cqlNewField                  = "New"
+	cqlCollection                = "Collection"
+	cqlNewCollection             = "NewCollection"
+	cqlNewCompositeCollection    = "NewCompositeCollection"
 	// cql/model
-	modelPath               = cqlPath + "/model"
-	uIntID                  = "UIntID"
-	uuid                    = "UUID"
-	uuidModel               = "UUIDModel"
-	uuidModelWithTimestamps = "UUIDModelWithTimestamps"
-	uIntModel               = "UIntModel"
-	uIntModelWithTimestamps = "UIntModelWithTimestamps"
+	modelPath                       = cqlPath + "/model"
+	uIntID                          = "UIntID"
+	uuid                            = "UUID"
+	uuidModel                       = "UUIDModel"
+	uuidModelWithTimestamps         = "UUIDModelWithTimestamps"
+	uuidV7Model                     = "UUIDv7Model"
+	uuidV7ModelWithTimestamps       = "UUIDv7ModelWithTimestamps"
+	uIntModel                       = "UIntModel"
+	uIntModelWithTimestamps         = "UIntModelWithTimestamps"
+	int64ID                         = "Int64ID"
+	int64Model                      = "Int64Model"
+	int64ModelWithTimestamps        = "Int64ModelWithTimestamps"
+	stringID                        = "StringID"
+	stringModel                     = "StringModel"
+	stringModelWithTimestamps       = "StringModelWithTimestamps"
+	idModel                         = "IDModel"
+	idModelWithTimestamps           = "IDModelWithTimestamps"
+	compositeKeyModel               = "CompositeKeyModel"
+	compositeKeyModelWithTimestamps = "CompositeKeyModelWithTimestamps"
+	versioned                       = "Versioned"
+	jsonType                        = "JSON"
 )
 
 const preloadMethod = "preload"
@@ -67,6 +87,18 @@ func NewCondition(destPkg string, objectType Type, field Field) *Condition {
 
 // Generate the condition between the object and the field
 func (condition *Condition) generate(objectType Type, field Field) {
+	if field.IsJSON() {
+		// the field contains a json document (of any type)
+		// adapt param to that type and generate a JSONField
+		condition.param.ToJSON(condition.destPkg, field.GetType())
+		condition.createField(
+			objectType,
+			field,
+		)
+
+		return
+	}
+
 	switch fieldType := field.GetType().(type) {
 	case *types.Basic:
 		// the field is a basic type (string, int, etc)
@@ -145,7 +177,7 @@ func (condition *Condition) generateForNamedType(objectType Type, field Field) {
 			objectType,
 			field,
 		)
-	case field.Type.IsGormCustomType() || field.TypeString() == "time.Time" || field.IsModelID():
+	case field.Type.IsGormCustomType() || field.TypeString() == "time.Time" || field.IsModelID(objectType.Pkg()):
 		// field is a Gorm Custom type (implements Scanner and Valuer interfaces)
 		// or a named type supported by gorm (time.Time)
 		// or a cql id (uuid or uintid)
@@ -211,12 +243,14 @@ func (condition *Condition) createField(objectType Type, field Field) {
 	var newFieldQual *jen.Statement
 
 	switch {
+	case condition.param.isJSON:
+		fieldQual, newFieldQual = condition.twoGenericField(field, objectTypeQual, cqlNullableJSONField, cqlJSONField, cqlJSONField)
 	case condition.param.isString:
 		fieldQual, newFieldQual = condition.oneGenericField(field, objectTypeQual, cqlNullableStringField, cqlStringField, cqlStringField)
 	case condition.param.isBool:
 		fieldQual, newFieldQual = condition.oneGenericField(field, objectTypeQual, cqlNullableBoolField, cqlBoolField, cqlBoolField)
 	case condition.param.isNumeric:
-		fieldQual, newFieldQual = condition.twoGenericField(field, objectTypeQual, cqlNullableNumericField, cqlNumericField, cqlNumericField)
+		fieldQual, newFieldQual = condition.twoGenericField(field, objectTypeQual, cqlNullableNumericField, cqlNumericField, cqlNotUpdatableNumericField)
 	default:
 		fieldQual, newFieldQual = condition.twoGenericField(field, objectTypeQual, cqlNullableField, cqlUpdatableField, cqlField)
 	}
@@ -284,11 +318,13 @@ func (condition *Condition) switchFieldType(
 // Generate a JoinCondition between the object and field's object
 // when object has a foreign key to the field's object
 func (condition *Condition) generateJoinWithFK(objectType Type, field Field) {
+	referencesAttributes := field.getFKReferencesAttributes(field.Type)
+
 	condition.generateJoin(
 		objectType,
 		field,
-		field.getFKAttribute(),
-		field.getFKReferencesAttribute(),
+		field.getFKAttributes(referencesAttributes),
+		referencesAttributes,
 	)
 }
 
@@ -296,16 +332,18 @@ func (condition *Condition) generateJoinWithFK(objectType Type, field Field) {
 // when object has not a foreign key to the field's object
 // (so the field's object has it)
 func (condition *Condition) generateJoinWithoutFK(objectType Type, field Field) {
+	referencesAttributes := field.getFKReferencesAttributes(objectType)
+
 	condition.generateJoin(
 		objectType,
 		field,
-		field.getFKReferencesAttribute(),
-		field.getRelatedTypeFKAttribute(objectType.Name()),
+		referencesAttributes,
+		field.getRelatedTypeFKAttributes(objectType.Name(), referencesAttributes),
 	)
 }
 
 // Generate a JoinCondition
-func (condition *Condition) generateJoin(objectType Type, field Field, t1Field, t2Field string) {
+func (condition *Condition) generateJoin(objectType Type, field Field, t1Fields, t2Fields []string) {
 	t1 := jen.Qual(
 		getRelativePackagePath(condition.destPkg, objectType),
 		objectType.Name(),
@@ -325,8 +363,15 @@ func (condition *Condition) generateJoin(objectType Type, field Field, t1Field,
 	ormT2Condition := jen.Qual(
 		conditionPath, cqlCondition,
 	).Types(t2)
+
+	newJoinCondition := cqlNewJoinCondition
+	if len(t1Fields) > 1 {
+		// composite foreign key
+		newJoinCondition = cqlNewCompositeJoinCondition
+	}
+
 	ormJoinCondition := jen.Qual(
-		conditionPath, cqlNewJoinCondition,
+		conditionPath, newJoinCondition,
 	).Types(
 		t1, t2,
 	)
@@ -340,9 +385,9 @@ func (condition *Condition) generateJoin(objectType Type, field Field, t1Field,
 			ormJoinCondition.Call(
 				jen.Id("conditions"),
 				jen.Lit(field.Name),
-				jen.Lit(t1Field),
+				fieldsLit(t1Fields),
 				jen.Id(condition.modelType).Dot(preloadMethod).Call(),
-				jen.Lit(t2Field),
+				fieldsLit(t2Fields),
 				jen.Id(field.Type.Name()).Dot(preloadMethod).Call(),
 			),
 		),
@@ -369,20 +414,42 @@ func (condition *Condition) createCollection(objectType Type, field Field) {
 		),
 	)
 
+	referencesAttributes := field.getFKReferencesAttributes(objectType)
+
+	newCollection := cqlNewCollection
+	if len(referencesAttributes) > 1 {
+		// composite foreign key
+		newCollection = cqlNewCompositeCollection
+	}
+
 	condition.FieldDefinition = jen.Qual(
-		conditionPath, cqlNewCollection,
+		conditionPath, newCollection,
 	).Types(
 		t1,
 		t2,
 	).Call(
 		jen.Lit(field.Name),
-		jen.Lit(field.getFKReferencesAttribute()),
-		jen.Lit(field.getRelatedTypeFKAttribute(objectType.Name())),
+		fieldsLit(referencesAttributes),
+		fieldsLit(field.getRelatedTypeFKAttributes(objectType.Name(), referencesAttributes)),
 	)
 
 	condition.FieldIsCollection = true
 }
 
+// Generate the literal of a list of fields:
+// a string if it has only one field or a []string if it has more (composite foreign keys)
+func fieldsLit(fields []string) *jen.Statement {
+	if len(fields) == 1 {
+		return jen.Lit(fields[0])
+	}
+
+	return jen.Index().String().Values(
+		pie.Map(fields, func(field string) jen.Code {
+			return jen.Lit(field)
+		})...,
+	)
+}
+
 // Generate condition names
 func getConditionName(field Field) string {
 	return strcase.ToPascal(field.NamePrefix) + strcase.ToPascal(field.Name)
@@ -21,7 +21,15 @@ func generateForEmbeddedField[T any](file *File, field Field, generator CodeGene
 		return []T{}
 	}
 
-	if !isBaseModel(field.TypeString()) {
+	switch {
+	case isVersioned(field.TypeString()):
+		// version is managed by cql, so it can not be set
+		fields = pie.Map(fields, func(embeddedField Field) Field {
+			embeddedField.IsVersion = true
+
+			return embeddedField
+		})
+	case !isBaseModel(field.TypeString()):
 		fields = pie.Map(fields, func(embeddedField Field) Field {
 			embeddedField.ColumnPrefix = field.Tags.getEmbeddedPrefix()
 			embeddedField.NamePrefix = field.Name
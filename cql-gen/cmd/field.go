@@ -12,6 +12,8 @@ var (
 	modelIDs = []string{
 		modelPath + "." + uIntID,
 		modelPath + "." + uuid,
+		modelPath + "." + int64ID,
+		modelPath + "." + stringID,
 	}
 	baseModelFields = []string{
 		"ID", "CreatedAt", "UpdatedAt", "DeletedAt",
@@ -25,18 +27,34 @@ type Field struct {
 	Embedded     bool
 	Tags         GormTags
 	ColumnPrefix string
+	// true if the field is the version of a model that uses optimistic locking (model.Versioned)
+	IsVersion bool
 }
 
 func (field Field) CompleteName() string {
 	return field.NamePrefix + field.Name
 }
 
-func (field Field) IsModelID() bool {
-	return pie.Contains(modelIDs, field.TypeString())
+// Returns true if the field is a cql id: one of the ids of cql/model
+// or the TID of a model.IDModel[TID] used by a model of the package of the type or of pkg
+func (field Field) IsModelID(pkg *types.Package) bool {
+	return pie.Contains(modelIDs, field.TypeString()) || field.Type.IsID(pkg)
+}
+
+// Returns true if the field contains a json document:
+// a json type (model.JSON, gorm's datatypes.JSON, etc.)
+// or a field with the gorm tags type:json, type:jsonb or serializer:json.
+// Pointers are not considered, as they are transformed into the pointed type before
+func (field Field) IsJSON() bool {
+	if _, isPointer := field.GetType().(*types.Pointer); isPointer {
+		return false
+	}
+
+	return field.Type.IsJSON() || field.Tags.isJSON()
 }
 
 func (field Field) IsUpdatable() bool {
-	return !pie.Contains(baseModelFields, field.Name)
+	return !field.IsVersion && !pie.Contains(baseModelFields, field.Name)
 }
 
 func (field Field) IsNullable() bool {
@@ -54,40 +72,46 @@ func (field Field) getColumnName() string {
 	return ""
 }
 
-// Get name of the attribute of the object that is a foreign key to the field's object
-func (field Field) getFKAttribute() string {
+// Get names of the attributes of the object that are a foreign key to the field's object,
+// where referencesAttributes are the attributes of the field's object referenced by the foreign key
+func (field Field) getFKAttributes(referencesAttributes []string) []string {
 	foreignKeyTag, isPresent := field.Tags[foreignKeyTagName]
 	if isPresent {
-		// field has a foreign key tag, so the name will be that tag
-		return foreignKeyTag
+		// field has a foreign key tag, so the names will be that tag
+		return splitTagValues(foreignKeyTag)
 	}
 
 	// gorm default
-	return field.Name + "ID"
+	return pie.Map(referencesAttributes, func(referencesAttribute string) string {
+		return field.Name + referencesAttribute
+	})
 }
 
-// Get name of the attribute of the field's object that is references by the foreign key
-func (field Field) getFKReferencesAttribute() string {
+// Get names of the attributes of referencedType that are referenced by the foreign key
+func (field Field) getFKReferencesAttributes(referencedType Type) []string {
 	referencesTag, isPresent := field.Tags[referencesTagName]
 	if isPresent {
-		// field has a references tag, so the name will be that tag
-		return referencesTag
+		// field has a references tag, so the names will be that tag
+		return splitTagValues(referencesTag)
 	}
 
 	// gorm default
-	return "ID"
+	return referencedType.PrimaryKeyAttributes()
 }
 
-// Get name of the attribute of field's object that is a foreign key to the object
-func (field Field) getRelatedTypeFKAttribute(structName string) string {
+// Get names of the attributes of field's object that are a foreign key to the object,
+// where referencesAttributes are the attributes of the object referenced by the foreign key
+func (field Field) getRelatedTypeFKAttributes(structName string, referencesAttributes []string) []string {
 	foreignKeyTag, isPresent := field.Tags[foreignKeyTagName]
 	if isPresent {
-		// field has a foreign key tag, so the name will that tag
-		return foreignKeyTag
+		// field has a foreign key tag, so the names will be that tag
+		return splitTagValues(foreignKeyTag)
 	}
 
 	// gorm default
-	return structName + "ID"
+	return pie.Map(referencesAttributes, func(referencesAttribute string) string {
+		return structName + referencesAttribute
+	})
 }
 
 func (field Field) GetType() types.Type {
@@ -3,6 +3,7 @@ package cmd
 import (
 	"strings"
 
+	"github.com/elliotchance/pie/v2"
 	"github.com/fatih/structtag"
 )
 
@@ -15,8 +16,14 @@ const (
 	foreignKeyTagName     GormTag = "foreignKey"
 	referencesTagName     GormTag = "references"
 	notNullTagName        GormTag = "not null"
+	primaryKeyTagName     GormTag = "primaryKey"
+	typeTagName           GormTag = "type"
+	serializerTagName     GormTag = "serializer"
 )
 
+// values of the type and serializer tags of the fields that contain a json document
+var jsonTagValues = []string{"json", "jsonb"}
+
 type GormTags map[GormTag]string
 
 func (tags GormTags) getEmbeddedPrefix() string {
@@ -36,11 +43,40 @@ func (tags GormTags) hasNotNull() bool {
 	return tags.hasTag(notNullTagName)
 }
 
+func (tags GormTags) hasPrimaryKey() bool {
+	// gorm tag names are case insensitive (primaryKey or primarykey)
+	for name := range tags {
+		if strings.EqualFold(string(name), string(primaryKeyTagName)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns true if the field is stored as a json document (type:json, type:jsonb or serializer:json)
+func (tags GormTags) isJSON() bool {
+	// gorm tag names are case insensitive
+	for name, value := range tags {
+		if (strings.EqualFold(string(name), string(typeTagName)) || strings.EqualFold(string(name), string(serializerTagName))) &&
+			pie.Contains(jsonTagValues, strings.ToLower(strings.TrimSpace(value))) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (tags GormTags) hasTag(name GormTag) bool {
 	_, isPresent := tags[name]
 	return isPresent
 }
 
+// Returns the values of a tag that accepts a list of values (foreignKey:A,B)
+func splitTagValues(tagValue string) []string {
+	return pie.Map(strings.Split(tagValue, ","), strings.TrimSpace)
+}
+
 func getGormTags(tag string) GormTags {
 	tagMap := GormTags{}
 
@@ -54,7 +90,8 @@ func getGormTags(tag string) GormTags {
 		return tagMap
 	}
 
-	gormTags := strings.Split(gormTag.Name, ";")
+	// Value is used instead of Name as Name does not include the values after a comma (foreignKey:A,B)
+	gormTags := strings.Split(gormTag.Value(), ";")
 	for _, tag := range gormTags {
 		splitted := strings.Split(tag, ":")
 		tagName := GormTag(splitted[0])
@@ -13,6 +13,7 @@ type JenParam struct {
 	isString     bool
 	isSlice      bool
 	isNumeric    bool
+	isJSON       bool
 }
 
 func NewJenParam() *JenParam {
@@ -82,6 +83,44 @@ func (param JenParam) ToCustomType(destPkg string, typeV Type) {
 	)
 }
 
+func (param *JenParam) ToJSON(destPkg string, jsonType types.Type) {
+	param.isJSON = true
+	param.internalType.Add(jenType(destPkg, jsonType))
+}
+
+// Returns the code of a type of any kind (named, basic, pointer, slice, map, etc.)
+func jenType(destPkg string, typeV types.Type) *jen.Statement {
+	switch typed := typeV.(type) {
+	case *types.Named:
+		typeArgs := []jen.Code{}
+		for i := 0; i < typed.TypeArgs().Len(); i++ {
+			typeArgs = append(typeArgs, jenType(destPkg, typed.TypeArgs().At(i)))
+		}
+
+		qual := jen.Qual(getRelativePackagePath(destPkg, Type{Type: typed}), typed.Obj().Name())
+		if len(typeArgs) != 0 {
+			qual = qual.Types(typeArgs...)
+		}
+
+		return qual
+	case *types.Alias:
+		return jenType(destPkg, types.Unalias(typed))
+	case *types.Basic:
+		return jen.Id(typed.Name())
+	case *types.Pointer:
+		return jen.Op("*").Add(jenType(destPkg, typed.Elem()))
+	case *types.Slice:
+		return jen.Index().Add(jenType(destPkg, typed.Elem()))
+	case *types.Array:
+		return jen.Index(jen.Lit(int(typed.Len()))).Add(jenType(destPkg, typed.Elem()))
+	case *types.Map:
+		return jen.Map(jenType(destPkg, typed.Key())).Add(jenType(destPkg, typed.Elem()))
+	default:
+		// interfaces, anonymous structs, etc.
+		return jen.Any()
+	}
+}
+
 func (param *JenParam) SQLToBasicType(typeV Type) {
 	switch typeV.String() {
 	case nullString:
@@ -11,11 +11,12 @@ import (
 
 const (
 	// cql/preload
-	preloadPath                  = cqlPath + "/preload"
-	cqlVerifyStructLoaded        = "VerifyStructLoaded"
-	cqlVerifyPointerLoaded       = "VerifyPointerLoaded"
-	cqlVerifyPointerWithIDLoaded = "VerifyPointerWithIDLoaded"
-	cqlVerifyCollectionLoaded    = "VerifyCollectionLoaded"
+	preloadPath                   = cqlPath + "/preload"
+	cqlVerifyStructLoaded         = "VerifyStructLoaded"
+	cqlVerifyPointerLoaded        = "VerifyPointerLoaded"
+	cqlVerifyPointerWithIDLoaded  = "VerifyPointerWithIDLoaded"
+	cqlVerifyPointerWithKeyLoaded = "VerifyPointerWithKeyLoaded"
+	cqlVerifyCollectionLoaded     = "VerifyCollectionLoaded"
 )
 
 type RelationGettersGenerator struct {
@@ -102,9 +103,15 @@ func (generator RelationGettersGenerator) generateForPointer(field Field) jen.Co
 
 			log.Logger.Debugf("Generating relation getter for type %q and field %s", generator.object.Name(), field.Name)
 
+			fkAttributes := field.getFKAttributes(field.getFKReferencesAttributes(field.Type))
+			if len(fkAttributes) > 1 {
+				// the fk is composite
+				return generator.verifyPointerWithKey(field, fkAttributes)
+			}
+
 			switch fk.GetType().(type) {
 			case *types.Named:
-				if fk.IsModelID() {
+				if fk.IsModelID(generator.objectType.Pkg()) {
 					return generator.verifyPointerWithID(field)
 				}
 			case *types.Pointer:
@@ -162,6 +169,22 @@ func (generator RelationGettersGenerator) verifyPointerWithID(field Field) *jen.
 	return generator.verifyPointerCommon(field, cqlVerifyPointerWithIDLoaded)
 }
 
+func (generator RelationGettersGenerator) verifyPointerWithKey(field Field, fkAttributes []string) *jen.Statement {
+	callParams := []jen.Code{jen.Id("m").Op(".").Id(field.Name)}
+
+	for _, fkAttribute := range fkAttributes {
+		callParams = append(callParams, jen.Id("m").Op(".").Id(fkAttribute))
+	}
+
+	return generator.verifyCommon(
+		field,
+		cqlVerifyPointerWithKeyLoaded,
+		jen.Op("*"),
+		nil,
+		callParams...,
+	)
+}
+
 func (generator RelationGettersGenerator) verifyCollection(field Field, fieldTypePrefix *jen.Statement) jen.Code {
 	return generator.verifyCommon(
 		field,
@@ -19,7 +19,18 @@ var (
 		modelPath + "." + uIntModel,
 		modelPath + "." + uuidModelWithTimestamps,
 		modelPath + "." + uIntModelWithTimestamps,
+		modelPath + "." + uuidV7Model,
+		modelPath + "." + uuidV7ModelWithTimestamps,
+		modelPath + "." + int64Model,
+		modelPath + "." + int64ModelWithTimestamps,
+		modelPath + "." + stringModel,
+		modelPath + "." + stringModelWithTimestamps,
+		modelPath + "." + idModel,
+		modelPath + "." + idModelWithTimestamps,
+		modelPath + "." + compositeKeyModel,
+		modelPath + "." + compositeKeyModelWithTimestamps,
 	}
+	cqlVersioned = modelPath + "." + versioned
 
 	// database/sql
 	nullString       = "database/sql.NullString"
@@ -35,6 +46,16 @@ var (
 		nullString, nullInt64, nullInt32, nullInt16, nullFloat64,
 		nullByte, nullBool, nullTime, deletedAt,
 	}
+
+	// json documents
+	datatypesPath = "gorm.io/datatypes"
+	jsonTypes     = []string{
+		modelPath + "." + jsonType,
+		datatypesPath + ".JSON",
+		datatypesPath + ".JSONMap",
+		datatypesPath + ".JSONSlice",
+		datatypesPath + ".JSONType",
+	}
 )
 
 var ErrFkNotInTypeFields = errors.New("fk not in type's fields")
@@ -90,9 +111,16 @@ func isCQLModel(structType *types.Struct) bool {
 }
 
 func isBaseModel(fieldName string) bool {
+	// generic base models (IDModel[TID]) are compared without their type arguments
+	fieldName, _, _ = strings.Cut(fieldName, "[")
+
 	return pie.Contains(cqlBaseModels, fieldName)
 }
 
+func isVersioned(fieldName string) bool {
+	return fieldName == cqlVersioned
+}
+
 // Returns the fk field of the type to the "field"'s object
 // (another field that references that object)
 func (t Type) GetFK(field Field) (*Field, error) {
@@ -101,8 +129,11 @@ func (t Type) GetFK(field Field) (*Field, error) {
 		return nil, err
 	}
 
+	// for composite foreign keys, the first attribute of the key is searched
+	fkAttribute := field.getFKAttributes(field.getFKReferencesAttributes(field.Type))[0]
+
 	fk := utils.FindFirst(objectFields, func(otherField Field) bool {
-		return strings.EqualFold(otherField.Name, field.getFKAttribute())
+		return strings.EqualFold(otherField.Name, fkAttribute)
 	})
 
 	if fk == nil {
@@ -112,6 +143,26 @@ func (t Type) GetFK(field Field) (*Field, error) {
 	return fk, nil
 }
 
+// Get the names of the attributes that compose the primary key of the cql model:
+// the attributes with the gorm primaryKey tag (composite primary key)
+// or ID for the models that embed a base model with id
+func (t Type) PrimaryKeyAttributes() []string {
+	fields, err := getFields(t)
+	if err == nil {
+		primaryKeyFields := pie.Filter(fields, func(field Field) bool {
+			return field.Tags.hasPrimaryKey()
+		})
+
+		if len(primaryKeyFields) > 0 {
+			return pie.Map(primaryKeyFields, func(field Field) string {
+				return field.Name
+			})
+		}
+	}
+
+	return []string{"ID"}
+}
+
 var (
 	scanMethod  = regexp.MustCompile(`func \(\*.*\)\.Scan\([a-zA-Z0-9_-]* (interface\{\}|any)\) error$`)
 	valueMethod = regexp.MustCompile(`func \(.*\)\.Value\(\) \(database/sql/driver\.Value\, error\)$`)
@@ -140,6 +191,68 @@ func (t Type) IsGormCustomType() bool {
 	return hasScanMethod && hasValueMethod
 }
 
+// Returns true if the type is the identifier of a cql model (the TID of its model.IDModel[TID] or model.IDModelWithTimestamps[TID])
+// declared in the package of the type or in pkg
+func (t Type) IsID(pkg *types.Package) bool {
+	if _, isNamedType := t.Type.(*types.Named); !isNamedType {
+		return false
+	}
+
+	return isIDOfModelIn(t.Pkg(), t.Type) || isIDOfModelIn(pkg, t.Type)
+}
+
+// Returns true if idType is the TID of the model.IDModel[TID] or model.IDModelWithTimestamps[TID]
+// embedded by one of the types declared in pkg
+func isIDOfModelIn(pkg *types.Package, idType types.Type) bool {
+	if pkg == nil {
+		return false
+	}
+
+	for _, name := range pkg.Scope().Names() {
+		typeName, isTypeName := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !isTypeName {
+			continue
+		}
+
+		structType, isStruct := typeName.Type().Underlying().(*types.Struct)
+		if !isStruct {
+			continue
+		}
+
+		for i := 0; i < structType.NumFields(); i++ {
+			field := structType.Field(i)
+			if !field.Embedded() {
+				continue
+			}
+
+			baseModel, isNamed := field.Type().(*types.Named)
+			if isNamed && isIDModel(baseModel) && types.Identical(baseModel.TypeArgs().At(0), idType) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Returns true if the type is model.IDModel[TID] or model.IDModelWithTimestamps[TID]
+func isIDModel(namedType *types.Named) bool {
+	object := namedType.Obj()
+
+	return object.Pkg() != nil &&
+		object.Pkg().Path() == modelPath &&
+		(object.Name() == idModel || object.Name() == idModelWithTimestamps) &&
+		namedType.TypeArgs().Len() == 1
+}
+
+// Returns true if the type is a json document (model.JSON or gorm's datatypes.JSON, JSONMap, etc.)
+func (t Type) IsJSON() bool {
+	// generic types (datatypes.JSONType[T]) are compared without their type arguments
+	typeName, _, _ := strings.Cut(t.String(), "[")
+
+	return pie.Contains(jsonTypes, typeName)
+}
+
 // Returns true if the type is a sql nullable type (sql.NullBool, sql.NullInt, etc.)
 func (t Type) IsSQLNullableType() bool {
 	return pie.Contains(sqlNullableTypes, t.String())
@@ -0,0 +1,34 @@
+package compositekey
+
+import (
+	"github.com/FrancoLiberali/cql/model"
+)
+
+type Warehouse struct {
+	model.CompositeKeyModel
+
+	Zone   uint    `gorm:"primaryKey"`
+	Code   string  `gorm:"primaryKey"`
+	Stocks []Stock // Warehouse HasMany Stock
+}
+
+func (m Warehouse) IsLoaded() bool {
+	return m.Zone != 0 || m.Code != ""
+}
+
+type Stock struct {
+	model.UUIDModel
+
+	Warehouse     *Warehouse
+	WarehouseZone *uint
+	WarehouseCode *string
+}
+
+type Shipment struct {
+	model.UUIDModel
+
+	// Shipment belongsTo Warehouse (with foreignKey and references)
+	Origin     Warehouse `gorm:"foreignKey:OriginZone,OriginCode;references:Zone,Code"`
+	OriginZone uint
+	OriginCode string
+}
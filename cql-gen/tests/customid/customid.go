@@ -0,0 +1,38 @@
+package customid
+
+import (
+	"github.com/FrancoLiberali/cql/model"
+)
+
+type ULID string
+
+func (id ULID) IsNil() bool {
+	return id == ""
+}
+
+// implements IsNil but is not the id of any model, so it is not a cql id
+type Priority int
+
+func (priority Priority) IsNil() bool {
+	return priority == 0
+}
+
+type Int64Model struct {
+	model.Int64Model
+}
+
+type StringModelWithTimestamps struct {
+	model.StringModelWithTimestamps
+}
+
+type ULIDModel struct {
+	model.IDModel[ULID]
+
+	Int64Model   *Int64Model
+	Int64ModelID *model.Int64ID
+
+	StringModel   *StringModelWithTimestamps
+	StringModelID model.StringID
+
+	Priority Priority
+}
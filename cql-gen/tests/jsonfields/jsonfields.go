@@ -0,0 +1,19 @@
+package jsonfields
+
+import "github.com/FrancoLiberali/cql/model"
+
+type Address struct {
+	Street string
+	Number int
+}
+
+type JSONFields struct {
+	model.UUIDModel
+
+	Document         model.JSON
+	NullableDocument *model.JSON
+	Attributes       map[string]any `gorm:"serializer:json"`
+	Tags             []string       `gorm:"type:jsonb"`
+	Address          Address        `gorm:"serializer:json"`
+	AddressPointer   *Address       `gorm:"type:json"`
+}
@@ -14,6 +14,13 @@ import (
 
 const chunkSize = 100000
 
+func TestUUIDv7ModelWithTimestamps(t *testing.T) {
+	doTest(t, "./uuidv7modelwithtimestamps", []Comparison{
+		{Have: "time_ordered_model_conditions.go", Expected: "./results/uuidv7modelwithtimestamps.go"},
+	})
+	CheckFileNotExists(t, "./uuidv7modelwithtimestamps/cql.go")
+}
+
 func TestUIntModel(t *testing.T) {
 	doTest(t, "./uintmodel", []Comparison{
 		{Have: "uint_model_conditions.go", Expected: "./results/uintmodel.go"},
@@ -151,6 +158,38 @@ func TestHasManyWithPointers(t *testing.T) {
 	})
 }
 
+func TestCompositeKey(t *testing.T) {
+	doTest(t, "./compositekey", []Comparison{
+		{Have: "warehouse_conditions.go", Expected: "./results/compositekey_warehouse.go"},
+		{Have: "stock_conditions.go", Expected: "./results/compositekey_stock.go"},
+		{Have: "shipment_conditions.go", Expected: "./results/compositekey_shipment.go"},
+		{Have: "./compositekey/cql.go", Expected: "./compositekey/cql_result.go"},
+	})
+}
+
+func TestCustomID(t *testing.T) {
+	doTest(t, "./customid", []Comparison{
+		{Have: "int64_model_conditions.go", Expected: "./results/customid_int64_model.go"},
+		{Have: "string_model_with_timestamps_conditions.go", Expected: "./results/customid_string_model_with_timestamps.go"},
+		{Have: "ulid_model_conditions.go", Expected: "./results/customid_ulid_model.go"},
+		{Have: "./customid/cql.go", Expected: "./customid/cql_result.go"},
+	})
+}
+
+func TestJSONFields(t *testing.T) {
+	doTest(t, "./jsonfields", []Comparison{
+		{Have: "json_fields_conditions.go", Expected: "./results/jsonfields.go"},
+	})
+	CheckFileNotExists(t, "./jsonfields/cql.go")
+}
+
+func TestVersioned(t *testing.T) {
+	doTest(t, "./versioned", []Comparison{
+		{Have: "account_conditions.go", Expected: "./results/versioned.go"},
+	})
+	CheckFileNotExists(t, "./versioned/cql.go")
+}
+
 func TestSelfReferential(t *testing.T) {
 	doTest(t, "./selfreferential", []Comparison{
 		{Have: "employee_conditions.go", Expected: "./results/selfreferential.go"},
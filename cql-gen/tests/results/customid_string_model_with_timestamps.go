@@ -0,0 +1,28 @@
+// Code generated by cql-gen v0.1.0, DO NOT EDIT.
+package conditions
+
+import (
+	condition "github.com/FrancoLiberali/cql/condition"
+	customid "github.com/FrancoLiberali/cql/cql-gen/cmd/gen/conditions/tests/customid"
+	model "github.com/FrancoLiberali/cql/model"
+	"time"
+)
+
+type stringModelWithTimestampsConditions struct {
+	ID        condition.Field[customid.StringModelWithTimestamps, model.StringID]
+	CreatedAt condition.Field[customid.StringModelWithTimestamps, time.Time]
+	UpdatedAt condition.Field[customid.StringModelWithTimestamps, time.Time]
+	DeletedAt condition.Field[customid.StringModelWithTimestamps, time.Time]
+}
+
+var StringModelWithTimestamps = stringModelWithTimestampsConditions{
+	CreatedAt: condition.NewField[customid.StringModelWithTimestamps, time.Time]("CreatedAt", "", ""),
+	DeletedAt: condition.NewField[customid.StringModelWithTimestamps, time.Time]("DeletedAt", "", ""),
+	ID:        condition.NewField[customid.StringModelWithTimestamps, model.StringID]("ID", "", ""),
+	UpdatedAt: condition.NewField[customid.StringModelWithTimestamps, time.Time]("UpdatedAt", "", ""),
+}
+
+// Preload allows preloading the StringModelWithTimestamps when doing a query
+func (stringModelWithTimestampsConditions stringModelWithTimestampsConditions) preload() condition.Condition[customid.StringModelWithTimestamps] {
+	return condition.NewPreloadCondition[customid.StringModelWithTimestamps](stringModelWithTimestampsConditions.ID, stringModelWithTimestampsConditions.CreatedAt, stringModelWithTimestampsConditions.UpdatedAt, stringModelWithTimestampsConditions.DeletedAt)
+}
@@ -0,0 +1,32 @@
+// Code generated by cql-gen v0.1.0, DO NOT EDIT.
+package conditions
+
+import (
+	condition "github.com/FrancoLiberali/cql/condition"
+	customid "github.com/FrancoLiberali/cql/cql-gen/cmd/gen/conditions/tests/customid"
+	model "github.com/FrancoLiberali/cql/model"
+)
+
+func (ulidModelConditions ulidModelConditions) Int64Model(conditions ...condition.Condition[customid.Int64Model]) condition.JoinCondition[customid.ULIDModel] {
+	return condition.NewJoinCondition[customid.ULIDModel, customid.Int64Model](conditions, "Int64Model", "Int64ModelID", ulidModelConditions.preload(), "ID", Int64Model.preload())
+}
+func (ulidModelConditions ulidModelConditions) StringModel(conditions ...condition.Condition[customid.StringModelWithTimestamps]) condition.JoinCondition[customid.ULIDModel] {
+	return condition.NewJoinCondition[customid.ULIDModel, customid.StringModelWithTimestamps](conditions, "StringModel", "StringModelID", ulidModelConditions.preload(), "ID", StringModelWithTimestamps.preload())
+}
+
+type ulidModelConditions struct {
+	ID            condition.Field[customid.ULIDModel, customid.ULID]
+	Int64ModelID  condition.NullableField[customid.ULIDModel, model.Int64ID]
+	StringModelID condition.UpdatableField[customid.ULIDModel, model.StringID]
+}
+
+var ULIDModel = ulidModelConditions{
+	ID:            condition.NewField[customid.ULIDModel, customid.ULID]("ID", "", ""),
+	Int64ModelID:  condition.NewNullableField[customid.ULIDModel, model.Int64ID]("Int64ModelID", "", ""),
+	StringModelID: condition.NewUpdatableField[customid.ULIDModel, model.StringID]("StringModelID", "", ""),
+}
+
+// Preload allows preloading the ULIDModel when doing a query
+func (ulidModelConditions ulidModelConditions) preload() condition.Condition[customid.ULIDModel] {
+	return condition.NewPreloadCondition[customid.ULIDModel](ulidModelConditions.ID, ulidModelConditions.Int64ModelID, ulidModelConditions.StringModelID)
+}
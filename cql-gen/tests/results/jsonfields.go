@@ -0,0 +1,33 @@
+// Code generated by cql-gen v0.1.0, DO NOT EDIT.
+package conditions
+
+import (
+	condition "github.com/FrancoLiberali/cql/condition"
+	jsonfields "github.com/FrancoLiberali/cql/cql-gen/cmd/gen/conditions/tests/jsonfields"
+	model "github.com/FrancoLiberali/cql/model"
+)
+
+type jsonFieldsConditions struct {
+	ID               condition.Field[jsonfields.JSONFields, model.UUID]
+	Document         condition.JSONField[jsonfields.JSONFields, model.JSON]
+	NullableDocument condition.NullableJSONField[jsonfields.JSONFields, model.JSON]
+	Attributes       condition.JSONField[jsonfields.JSONFields, map[string]any]
+	Tags             condition.JSONField[jsonfields.JSONFields, []string]
+	Address          condition.JSONField[jsonfields.JSONFields, jsonfields.Address]
+	AddressPointer   condition.NullableJSONField[jsonfields.JSONFields, jsonfields.Address]
+}
+
+var JSONFields = jsonFieldsConditions{
+	Address:          condition.NewJSONField[jsonfields.JSONFields, jsonfields.Address]("Address", "", ""),
+	AddressPointer:   condition.NewNullableJSONField[jsonfields.JSONFields, jsonfields.Address]("AddressPointer", "", ""),
+	Attributes:       condition.NewJSONField[jsonfields.JSONFields, map[string]any]("Attributes", "", ""),
+	Document:         condition.NewJSONField[jsonfields.JSONFields, model.JSON]("Document", "", ""),
+	ID:               condition.NewField[jsonfields.JSONFields, model.UUID]("ID", "", ""),
+	NullableDocument: condition.NewNullableJSONField[jsonfields.JSONFields, model.JSON]("NullableDocument", "", ""),
+	Tags:             condition.NewJSONField[jsonfields.JSONFields, []string]("Tags", "", ""),
+}
+
+// Preload allows preloading the JSONFields when doing a query
+func (jsonFieldsConditions jsonFieldsConditions) preload() condition.Condition[jsonfields.JSONFields] {
+	return condition.NewPreloadCondition[jsonfields.JSONFields](jsonFieldsConditions.ID, jsonFieldsConditions.Document, jsonFieldsConditions.NullableDocument, jsonFieldsConditions.Attributes, jsonFieldsConditions.Tags, jsonFieldsConditions.Address, jsonFieldsConditions.AddressPointer)
+}
@@ -0,0 +1,7 @@
+package uuidv7modelwithtimestamps
+
+import "github.com/FrancoLiberali/cql/model"
+
+type TimeOrderedModel struct {
+	model.UUIDv7ModelWithTimestamps
+}
@@ -0,0 +1,11 @@
+package versioned
+
+import "github.com/FrancoLiberali/cql/model"
+
+type Account struct {
+	model.UUIDModel
+	model.Versioned
+
+	Name    string
+	Balance int
+}
@@ -0,0 +1,61 @@
+package cql
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// With creates a common table expression (WITH) called name with the models obtained by query.
+//
+// name must be a valid identifier (letters, digits and underscores, not starting with a digit).
+// Only a Query can be used (and not a SelectQuery) as the rows of the common table expression
+// must be models to be queried using their conditions.
+//
+// Use cql.QueryFrom to query the models of the common table expression.
+//
+// For details see https://compiledquerylenguage.readthedocs.io/en/latest/cql/advanced_query.html#common-table-expressions
+func With[T model.Model](name string, query *condition.Query[T]) condition.CTE[T] {
+	return condition.NewCTE(name, query)
+}
+
+// WithRecursive creates a recursive common table expression (WITH RECURSIVE) called name.
+//
+// The models obtained by anchor are the initial rows of the common table expression,
+// then, the models whose field is equal to the previousField of a model already in the
+// common table expression (and fulfill the conditions) are added, until no more models are found.
+//
+// For example, to obtain an employee and all its subordinates (direct or not):
+//
+//	cql.WithRecursive(
+//		"subordinates",
+//		cql.Query[models.Employee](ctx, db, conditions.Employee.Name.Is().Eq(cql.String("franco"))),
+//		conditions.Employee.BossID,
+//		conditions.Employee.ID,
+//	)
+//
+// Use cql.QueryFrom to query the models of the common table expression.
+//
+// For details see https://compiledquerylenguage.readthedocs.io/en/latest/cql/advanced_query.html#common-table-expressions
+func WithRecursive[T model.Model, TAttribute any](
+	name string,
+	anchor *condition.Query[T],
+	field, previousField condition.FieldOfType[T, TAttribute],
+	conditions ...condition.Condition[T],
+) condition.CTE[T] {
+	return condition.NewRecursiveCTE(name, anchor, field, previousField, conditions...)
+}
+
+// Create a Query to which the conditions are applied inside transaction tx,
+// obtaining the models from the common table expression cte
+//
+// For details see https://compiledquerylenguage.readthedocs.io/en/latest/cql/advanced_query.html#common-table-expressions
+func QueryFrom[T model.Model](
+	ctx context.Context,
+	tx *DB,
+	cte condition.CTE[T],
+	conditions ...condition.Condition[T],
+) *condition.Query[T] {
+	return condition.NewQueryFrom(tx.gormDBWithContext(ctx), cte, conditions...)
+}
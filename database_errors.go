@@ -0,0 +1,344 @@
+package cql
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// ConstraintViolationError is the error returned when a statement violates a constraint of the database.
+//
+// It wraps the error returned by the database driver and the kind of violation
+// (ErrUniqueViolation, ErrForeignKeyViolation, ErrNotNullViolation or ErrCheckViolation),
+// so it can be verified using errors.Is(err, cql.ErrUniqueViolation)
+// and its information can be obtained using errors.As.
+//
+// The translation is registered by Open, so it is not done for DBs created directly from a gorm.DB
+type ConstraintViolationError struct {
+	// Kind of violation: ErrUniqueViolation, ErrForeignKeyViolation, ErrNotNullViolation or ErrCheckViolation
+	Kind error
+	// Name of the violated constraint (empty if it is not provided by the database)
+	Constraint string
+	// Name of the column that violated the constraint (empty if it is not provided by the database)
+	Column string
+	// Error returned by the database driver
+	Err error
+}
+
+func (err *ConstraintViolationError) Error() string {
+	return err.Kind.Error() + "; " + err.Err.Error()
+}
+
+func (err *ConstraintViolationError) Unwrap() []error {
+	return []error{err.Kind, err.Err}
+}
+
+// Postgres error codes
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	postgresNotNullViolation     = "23502"
+	postgresForeignKeyViolation  = "23503"
+	postgresUniqueViolation      = "23505"
+	postgresCheckViolation       = "23514"
+	postgresSerializationFailure = "40001"
+	postgresDeadlockDetected     = "40P01"
+)
+
+// MySQL error numbers
+// https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html
+const (
+	mysqlBadNull              = 1048
+	mysqlDuplicateEntry       = 1062
+	mysqlLockDeadlock         = 1213
+	mysqlNoDefaultForField    = 1364
+	mysqlRowIsReferenced      = 1451
+	mysqlNoReferencedRow      = 1452
+	mysqlCheckConstraintFails = 3819
+)
+
+// SQLServer error numbers
+// https://learn.microsoft.com/en-us/sql/relational-databases/errors-events/database-engine-events-and-errors
+const (
+	sqlServerNullNotAllowed            = 515
+	sqlServerConstraintConflict        = 547
+	sqlServerDeadlockVictim            = 1205
+	sqlServerDuplicateKeyInIndex       = 2601
+	sqlServerDuplicateKeyInConstraint  = 2627
+	sqlServerSnapshotIsolationConflict = 3960
+)
+
+// SQLite error messages
+// https://www.sqlite.org/rescode.html
+const (
+	sqliteBusyMessage       = "database is locked"
+	sqliteLockedMessage     = "database table is locked"
+	sqliteUniqueMessage     = "UNIQUE constraint failed: "
+	sqliteForeignKeyMessage = "FOREIGN KEY constraint failed"
+	sqliteNotNullMessage    = "NOT NULL constraint failed: "
+	sqliteCheckMessage      = "CHECK constraint failed: "
+)
+
+var (
+	mysqlKeyRegex        = regexp.MustCompile(`for key '(?:[^']*\.)?([^']+)'`)
+	mysqlForeignKeyRegex = regexp.MustCompile("CONSTRAINT `([^`]+)` FOREIGN KEY \\(`([^`]+)`")
+	mysqlColumnRegex     = regexp.MustCompile(`(?:Column|Field) '([^']+)'`)
+	mysqlCheckRegex      = regexp.MustCompile(`Check constraint '([^']+)'`)
+
+	sqlServerUniqueConstraintRegex = regexp.MustCompile(`(?:constraint|unique index) '([^']+)'`)
+	sqlServerConflictRegex         = regexp.MustCompile(`(FOREIGN KEY|REFERENCE|CHECK) constraint "([^"]+)"`)
+	sqlServerColumnRegex           = regexp.MustCompile(`column '([^']+)'`)
+)
+
+// error returned by the sqlserver driver
+type sqlServerError interface {
+	SQLErrorNumber() int32
+	SQLErrorMessage() string
+}
+
+// Returns true if err is a serialization failure or a deadlock in the database dialector
+func isRetryableError(dialector sql.Dialector, err error) bool {
+	switch dialector {
+	case sql.Postgres:
+		var postgresErr *pgconn.PgError
+
+		return errors.As(err, &postgresErr) &&
+			(postgresErr.Code == postgresSerializationFailure || postgresErr.Code == postgresDeadlockDetected)
+	case sql.MySQL:
+		var mysqlErr *mysql.MySQLError
+
+		return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlLockDeadlock
+	case sql.SQLServer:
+		var sqlServerErr sqlServerError
+
+		return errors.As(err, &sqlServerErr) &&
+			(sqlServerErr.SQLErrorNumber() == sqlServerDeadlockVictim ||
+				sqlServerErr.SQLErrorNumber() == sqlServerSnapshotIsolationConflict)
+	case sql.SQLite:
+		return strings.Contains(err.Error(), sqliteBusyMessage) || strings.Contains(err.Error(), sqliteLockedMessage)
+	}
+
+	return false
+}
+
+// Registers a callback that translates the errors returned by the database
+// to ConstraintViolationError after the execution of each statement
+func registerErrorTranslation(db *gorm.DB) error {
+	translate := func(tx *gorm.DB) {
+		if tx.Error != nil {
+			tx.Error = translateError(sql.Dialector(tx.Dialector.Name()), tx.Error)
+		}
+	}
+
+	callbacks := db.Callback()
+
+	return errors.Join(
+		callbacks.Create().After("*").Register("cql:translate_error", translate),
+		callbacks.Query().After("*").Register("cql:translate_error", translate),
+		callbacks.Update().After("*").Register("cql:translate_error", translate),
+		callbacks.Delete().After("*").Register("cql:translate_error", translate),
+		callbacks.Row().After("*").Register("cql:translate_error", translate),
+		callbacks.Raw().After("*").Register("cql:translate_error", translate),
+	)
+}
+
+// Returns err translated to a ConstraintViolationError
+// if it is a constraint violation in the database dialector, or err in other case
+func translateError(dialector sql.Dialector, err error) error {
+	var violation *ConstraintViolationError
+
+	if errors.As(err, &violation) {
+		// already translated
+		return err
+	}
+
+	switch dialector {
+	case sql.Postgres:
+		violation = postgresConstraintViolation(err)
+	case sql.MySQL:
+		violation = mysqlConstraintViolation(err)
+	case sql.SQLServer:
+		violation = sqlServerConstraintViolation(err)
+	case sql.SQLite:
+		violation = sqliteConstraintViolation(err)
+	}
+
+	if violation == nil {
+		return err
+	}
+
+	return violation
+}
+
+func postgresConstraintViolation(err error) *ConstraintViolationError {
+	var postgresErr *pgconn.PgError
+	if !errors.As(err, &postgresErr) {
+		return nil
+	}
+
+	var kind error
+
+	switch postgresErr.Code {
+	case postgresUniqueViolation:
+		kind = ErrUniqueViolation
+	case postgresForeignKeyViolation:
+		kind = ErrForeignKeyViolation
+	case postgresNotNullViolation:
+		kind = ErrNotNullViolation
+	case postgresCheckViolation:
+		kind = ErrCheckViolation
+	default:
+		return nil
+	}
+
+	return &ConstraintViolationError{
+		Kind:       kind,
+		Constraint: postgresErr.ConstraintName,
+		Column:     postgresErr.ColumnName,
+		Err:        err,
+	}
+}
+
+func mysqlConstraintViolation(err error) *ConstraintViolationError {
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) {
+		return nil
+	}
+
+	switch mysqlErr.Number {
+	case mysqlDuplicateEntry:
+		return &ConstraintViolationError{
+			Kind:       ErrUniqueViolation,
+			Constraint: firstSubmatch(mysqlKeyRegex, mysqlErr.Message, 1),
+			Err:        err,
+		}
+	case mysqlRowIsReferenced, mysqlNoReferencedRow:
+		return &ConstraintViolationError{
+			Kind:       ErrForeignKeyViolation,
+			Constraint: firstSubmatch(mysqlForeignKeyRegex, mysqlErr.Message, 1),
+			Column:     firstSubmatch(mysqlForeignKeyRegex, mysqlErr.Message, 2),
+			Err:        err,
+		}
+	case mysqlBadNull, mysqlNoDefaultForField:
+		return &ConstraintViolationError{
+			Kind:   ErrNotNullViolation,
+			Column: firstSubmatch(mysqlColumnRegex, mysqlErr.Message, 1),
+			Err:    err,
+		}
+	case mysqlCheckConstraintFails:
+		return &ConstraintViolationError{
+			Kind:       ErrCheckViolation,
+			Constraint: firstSubmatch(mysqlCheckRegex, mysqlErr.Message, 1),
+			Err:        err,
+		}
+	}
+
+	return nil
+}
+
+func sqlServerConstraintViolation(err error) *ConstraintViolationError {
+	var sqlServerErr sqlServerError
+	if !errors.As(err, &sqlServerErr) {
+		return nil
+	}
+
+	message := sqlServerErr.SQLErrorMessage()
+
+	switch sqlServerErr.SQLErrorNumber() {
+	case sqlServerDuplicateKeyInIndex, sqlServerDuplicateKeyInConstraint:
+		return &ConstraintViolationError{
+			Kind:       ErrUniqueViolation,
+			Constraint: firstSubmatch(sqlServerUniqueConstraintRegex, message, 1),
+			Err:        err,
+		}
+	case sqlServerConstraintConflict:
+		kind := ErrForeignKeyViolation
+		if firstSubmatch(sqlServerConflictRegex, message, 1) == "CHECK" {
+			kind = ErrCheckViolation
+		}
+
+		return &ConstraintViolationError{
+			Kind:       kind,
+			Constraint: firstSubmatch(sqlServerConflictRegex, message, 2),
+			Column:     firstSubmatch(sqlServerColumnRegex, message, 1),
+			Err:        err,
+		}
+	case sqlServerNullNotAllowed:
+		return &ConstraintViolationError{
+			Kind:   ErrNotNullViolation,
+			Column: firstSubmatch(sqlServerColumnRegex, message, 1),
+			Err:    err,
+		}
+	}
+
+	return nil
+}
+
+// sqlite driver does not provide error codes for each violation, so the message is used
+func sqliteConstraintViolation(err error) *ConstraintViolationError {
+	message := err.Error()
+
+	switch {
+	case strings.Contains(message, sqliteUniqueMessage):
+		return &ConstraintViolationError{
+			Kind:   ErrUniqueViolation,
+			Column: sqliteColumns(message, sqliteUniqueMessage),
+			Err:    err,
+		}
+	case strings.Contains(message, sqliteForeignKeyMessage):
+		return &ConstraintViolationError{
+			Kind: ErrForeignKeyViolation,
+			Err:  err,
+		}
+	case strings.Contains(message, sqliteNotNullMessage):
+		return &ConstraintViolationError{
+			Kind:   ErrNotNullViolation,
+			Column: sqliteColumns(message, sqliteNotNullMessage),
+			Err:    err,
+		}
+	case strings.Contains(message, sqliteCheckMessage):
+		_, constraint, _ := strings.Cut(message, sqliteCheckMessage)
+
+		return &ConstraintViolationError{
+			Kind:       ErrCheckViolation,
+			Constraint: constraint,
+			Err:        err,
+		}
+	}
+
+	return nil
+}
+
+// Returns the names of the columns in a sqlite error message that follow prefix,
+// that have the form "table.column1, table.column2"
+func sqliteColumns(message, prefix string) string {
+	_, tableColumns, _ := strings.Cut(message, prefix)
+
+	columns := []string{}
+
+	for _, tableColumn := range strings.Split(tableColumns, ", ") {
+		_, column, found := strings.Cut(tableColumn, ".")
+		if !found {
+			column = tableColumn
+		}
+
+		columns = append(columns, column)
+	}
+
+	return strings.Join(columns, ", ")
+}
+
+// Returns the submatch number index of the first match of regex in message or empty string if there is no match
+func firstSubmatch(regex *regexp.Regexp, message string, index int) string {
+	matches := regex.FindStringSubmatch(message)
+	if len(matches) <= index {
+		return ""
+	}
+
+	return matches[index]
+}
@@ -0,0 +1,184 @@
+package cql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	mssql "github.com/microsoft/go-mssqldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+
+	"github.com/FrancoLiberali/cql/sql"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+func TestInsertReturnsConstraintViolationError(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	db, err := Open(postgres.New(postgres.Config{
+		Conn: conn,
+	}))
+	require.NoError(t, err)
+
+	pgErr := &pgconn.PgError{
+		Code:           "23505",
+		ConstraintName: "bicycles_name_key",
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "bicycles"`).WillReturnError(pgErr)
+	mock.ExpectRollback()
+
+	_, err = Insert(context.Background(), db, &models.Bicycle{Name: "John Doe"}).Exec()
+	require.ErrorIs(t, err, ErrUniqueViolation)
+	require.ErrorIs(t, err, pgErr)
+
+	var violation *ConstraintViolationError
+
+	require.ErrorAs(t, err, &violation)
+	assert.Equal(t, "bicycles_name_key", violation.Constraint)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTranslateError(t *testing.T) {
+	tests := []struct {
+		name       string
+		dialector  sql.Dialector
+		err        error
+		kind       error
+		constraint string
+		column     string
+	}{
+		{
+			name:       "postgres not null",
+			dialector:  sql.Postgres,
+			err:        &pgconn.PgError{Code: "23502", ColumnName: "name"},
+			kind:       ErrNotNullViolation,
+			constraint: "",
+			column:     "name",
+		},
+		{
+			name:       "postgres foreign key",
+			dialector:  sql.Postgres,
+			err:        &pgconn.PgError{Code: "23503", ConstraintName: "fk_bicycles_owner"},
+			kind:       ErrForeignKeyViolation,
+			constraint: "fk_bicycles_owner",
+			column:     "",
+		},
+		{
+			name:       "mysql unique",
+			dialector:  sql.MySQL,
+			err:        &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'a' for key 'persons.idx_persons_name'"},
+			kind:       ErrUniqueViolation,
+			constraint: "idx_persons_name",
+			column:     "",
+		},
+		{
+			name:      "mysql foreign key",
+			dialector: sql.MySQL,
+			err: &mysql.MySQLError{
+				Number: 1452,
+				Message: "Cannot add or update a child row: a foreign key constraint fails " +
+					"(`db`.`bicycles`, CONSTRAINT `fk_bicycles_owner` FOREIGN KEY (`owner_name`) REFERENCES `persons` (`name`))",
+			},
+			kind:       ErrForeignKeyViolation,
+			constraint: "fk_bicycles_owner",
+			column:     "owner_name",
+		},
+		{
+			name:       "mysql not null",
+			dialector:  sql.MySQL,
+			err:        &mysql.MySQLError{Number: 1048, Message: "Column 'name' cannot be null"},
+			kind:       ErrNotNullViolation,
+			constraint: "",
+			column:     "name",
+		},
+		{
+			name:       "mysql check",
+			dialector:  sql.MySQL,
+			err:        &mysql.MySQLError{Number: 3819, Message: "Check constraint 'chk_code' is violated."},
+			kind:       ErrCheckViolation,
+			constraint: "chk_code",
+			column:     "",
+		},
+		{
+			name:      "sqlserver unique",
+			dialector: sql.SQLServer,
+			err: mssql.Error{
+				Number:  2627,
+				Message: "Violation of UNIQUE KEY constraint 'UQ_persons_name'. Cannot insert duplicate key in object 'dbo.persons'.",
+			},
+			kind:       ErrUniqueViolation,
+			constraint: "UQ_persons_name",
+			column:     "",
+		},
+		{
+			name:      "sqlserver check",
+			dialector: sql.SQLServer,
+			err: mssql.Error{
+				Number: 547,
+				Message: `The INSERT statement conflicted with the CHECK constraint "chk_code". ` +
+					`The conflict occurred in database "db", table "dbo.sales", column 'code'.`,
+			},
+			kind:       ErrCheckViolation,
+			constraint: "chk_code",
+			column:     "code",
+		},
+		{
+			name:      "sqlserver not null",
+			dialector: sql.SQLServer,
+			err: mssql.Error{
+				Number:  515,
+				Message: "Cannot insert the value NULL into column 'name', table 'db.dbo.persons'; column does not allow nulls. INSERT fails.",
+			},
+			kind:       ErrNotNullViolation,
+			constraint: "",
+			column:     "name",
+		},
+		{
+			name:       "sqlite unique",
+			dialector:  sql.SQLite,
+			err:        errors.New("UNIQUE constraint failed: sales.code, sales.description"),
+			kind:       ErrUniqueViolation,
+			constraint: "",
+			column:     "code, description",
+		},
+		{
+			name:       "sqlite check",
+			dialector:  sql.SQLite,
+			err:        errors.New("CHECK constraint failed: chk_code"),
+			kind:       ErrCheckViolation,
+			constraint: "chk_code",
+			column:     "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := translateError(test.dialector, test.err)
+			require.ErrorIs(t, err, test.kind)
+
+			var violation *ConstraintViolationError
+
+			require.ErrorAs(t, err, &violation)
+			assert.Equal(t, test.err, violation.Err)
+			assert.Equal(t, test.constraint, violation.Constraint)
+			assert.Equal(t, test.column, violation.Column)
+		})
+	}
+}
+
+func TestTranslateErrorNotViolation(t *testing.T) {
+	err := errors.New("other error")
+
+	assert.Equal(t, err, translateError(sql.SQLite, err))
+	assert.Equal(t, err, translateError(sql.Postgres, err))
+}
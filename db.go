@@ -9,6 +9,10 @@ import (
 	"github.com/FrancoLiberali/cql/logger"
 )
 
+// DB allows the communication with the database.
+//
+// The errors returned by the database are only translated to ConstraintViolationError
+// when it is created using Open (and not directly from a gorm.DB)
 type DB struct {
 	GormDB                *gorm.DB
 	withLoggerFromContext *LoggerFromContext
@@ -49,7 +53,8 @@ type (
 	Config    = gorm.Config
 )
 
-// Open initialize db session based on dialector
+// Open initialize db session based on dialector,
+// registering the translation of the constraint violation errors (see ConstraintViolationError)
 //
 // For details see https://compiledquerylenguage.readthedocs.io/en/latest/cql/connecting_to_a_database.html
 func Open(dialector Dialector, opts ...Option) (*DB, error) {
@@ -79,6 +84,11 @@ func Open(dialector Dialector, opts ...Option) (*DB, error) {
 		}
 	}
 
+	err = registerErrorTranslation(gormDB)
+	if err != nil {
+		return nil, err
+	}
+
 	db := &DB{
 		GormDB: gormDB,
 	}
@@ -19,3 +19,17 @@ func Delete[T model.Model](ctx context.Context, tx *DB, conditions ...condition.
 		conditions,
 	)
 }
+
+// Create a Delete of the loaded model (a model previously obtained from the database),
+// identified by its primary key, inside transaction tx.
+//
+// If the model uses optimistic locking (embeds model.Versioned), its version is also verified,
+// so cql.ErrStaleObject is returned if it was modified by other transaction since it was loaded.
+//
+// For details see https://compiledquerylenguage.readthedocs.io/en/latest/cql/update.html#optimistic-locking
+func DeleteModel[T model.Model](ctx context.Context, tx *DB, loaded *T) *condition.Delete[T] {
+	return condition.NewModelDelete(
+		tx.gormDBWithContext(ctx),
+		loaded,
+	)
+}
@@ -2,6 +2,7 @@ package cql
 
 import (
 	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/model"
 	"github.com/FrancoLiberali/cql/preload"
 )
 
@@ -11,21 +12,63 @@ var (
 	ErrFieldModelNotConcerned   = condition.ErrFieldModelNotConcerned
 	ErrAppearanceMustBeSelected = condition.ErrAppearanceMustBeSelected
 	ErrAppearanceOutOfRange     = condition.ErrAppearanceOutOfRange
+	ErrSelectionOutOfRange      = condition.ErrSelectionOutOfRange
+	ErrInvalidCTEName           = condition.ErrInvalidCTEName
+
+	// set operations
+
+	ErrSetOperationOrderOrLimit   = condition.ErrSetOperationOrderOrLimit
+	ErrSetOperationColumnMismatch = condition.ErrSetOperationColumnMismatch
 
 	// crud
 
 	ErrMoreThanOneObjectFound = condition.ErrMoreThanOneObjectFound
 	ErrObjectNotFound         = condition.ErrObjectNotFound
+	ErrModelNotLoaded         = condition.ErrModelNotLoaded
+	ErrStaleObject            = condition.ErrStaleObject
+	ErrNotSoftDeletable       = condition.ErrNotSoftDeletable
+	ErrInvalidBatchSize       = condition.ErrInvalidBatchSize
+	ErrPrimaryKeyNotGenerated = condition.ErrPrimaryKeyNotGenerated
 
 	// database
 
 	ErrUnsupportedByDatabase = condition.ErrUnsupportedByDatabase
 	ErrOrderByMustBeCalled   = condition.ErrOrderByMustBeCalled
 
+	// constraints (see ConstraintViolationError)
+
+	ErrUniqueViolation     = condition.ErrUniqueViolation
+	ErrForeignKeyViolation = condition.ErrForeignKeyViolation
+	ErrNotNullViolation    = condition.ErrNotNullViolation
+	ErrCheckViolation      = condition.ErrCheckViolation
+
+	// merge
+
+	ErrMergeWithoutActions = condition.ErrMergeWithoutActions
+	ErrFieldNotSet         = condition.ErrFieldNotSet
+
+	// transactions
+
+	ErrNotInTransaction         = condition.ErrNotInTransaction
+	ErrInvalidTransactionOption = condition.ErrInvalidTransactionOption
+
+	// uuid
+
+	ErrNotUUIDv7 = model.ErrNotUUIDv7
+
+	// keyset pagination
+
+	ErrKeysetOrderNotAllowed  = condition.ErrKeysetOrderNotAllowed
+	ErrKeysetOffsetNotAllowed = condition.ErrKeysetOffsetNotAllowed
+	ErrInvalidPageToken       = condition.ErrInvalidPageToken
+	ErrPageTokenOrderMismatch = condition.ErrPageTokenOrderMismatch
+
 	// preload
 
-	ErrOnlyPreloadsAllowed = condition.ErrOnlyPreloadsAllowed
-	ErrRelationNotLoaded   = preload.ErrRelationNotLoaded
+	ErrOnlyPreloadsAllowed          = condition.ErrOnlyPreloadsAllowed
+	ErrRelationNotLoaded            = preload.ErrRelationNotLoaded
+	ErrCollectionPreloadsNotAllowed = condition.ErrCollectionPreloadsNotAllowed
+	ErrJoinOnPreloadNotAllowed      = condition.ErrJoinOnPreloadNotAllowed
 
 	ErrPreloadsInDeleteReturningNotAllowed = condition.ErrPreloadsInDeleteReturningNotAllowed
 )
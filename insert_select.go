@@ -0,0 +1,35 @@
+package cql
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// InsertSelect creates an INSERT INTO ... SELECT statement that inserts into the table of T
+// the results of query, without obtaining them from the database.
+//
+// Each set defines the value (that can use the fields of the models of query) that will be inserted into a field of T.
+// The type of each value is verified in compilation time, so it must be the same as the type of the field.
+// Created at and updated at fields of T that are not set are set to the current time.
+//
+// The primary key of T must be generated by the database (for example, using model.UIntModel).
+//
+// For example, to archive the sales with code 1:
+//
+//	inserted, err := cql.InsertSelect[models.SaleArchive](
+//		ctx,
+//		db,
+//		cql.Query[models.Sale](ctx, db, conditions.Sale.Code.Is().Eq(cql.Int(1))),
+//		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+//		conditions.SaleArchive.Description.Set().Eq(conditions.Sale.Description),
+//	).Exec()
+func InsertSelect[T model.Model](
+	ctx context.Context,
+	tx *DB,
+	query condition.IQuery,
+	sets ...*condition.Set[T],
+) *condition.InsertSelect[T] {
+	return condition.NewInsertSelect(tx.gormDBWithContext(ctx), query, sets)
+}
@@ -0,0 +1,47 @@
+package cql
+
+import (
+	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// JoinOn joins T1 with T2 (INNER JOIN) where the value of t1Field is equal to the value of t2Field,
+// without the need of a relation between the models.
+//
+// The conditions are applied to T2 and,
+// once joined, the fields of T2 can be used in dynamic operators, order, select, etc.
+//
+// For example, to obtain the sales that have the same code as an import:
+//
+//	cql.Query[models.Sale](
+//		ctx,
+//		db,
+//		cql.JoinOn(
+//			conditions.Sale.Code,
+//			conditions.Import.Code,
+//			conditions.Import.Status.Is().Eq(cql.String("done")),
+//		),
+//	)
+//
+// For details see https://compiledquerylenguage.readthedocs.io/en/latest/cql/advanced_query.html#explicit-joins
+func JoinOn[T1, T2 model.Model, TAttribute any](
+	t1Field condition.FieldOfType[T1, TAttribute],
+	t2Field condition.FieldOfType[T2, TAttribute],
+	conditions ...condition.Condition[T2],
+) condition.JoinCondition[T1] {
+	return condition.NewExplicitJoinCondition(t1Field, t2Field, condition.InnerJoin, conditions)
+}
+
+// LeftJoinOn joins T1 with T2 (LEFT JOIN) where the value of t1Field is equal to the value of t2Field,
+// without the need of a relation between the models.
+//
+// Unlike JoinOn, models of T1 are obtained even if there is no model of T2 that fulfills the conditions.
+//
+// For details see https://compiledquerylenguage.readthedocs.io/en/latest/cql/advanced_query.html#explicit-joins
+func LeftJoinOn[T1, T2 model.Model, TAttribute any](
+	t1Field condition.FieldOfType[T1, TAttribute],
+	t2Field condition.FieldOfType[T2, TAttribute],
+	conditions ...condition.Condition[T2],
+) condition.JoinCondition[T1] {
+	return condition.NewExplicitJoinCondition(t1Field, t2Field, condition.LeftJoin, conditions)
+}
@@ -0,0 +1,39 @@
+package cql
+
+import (
+	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// SkipLocked makes the rows that are already locked by other transactions to be skipped
+// instead of waiting for them to be released.
+//
+// For example, to take the next pending job of a queue:
+//
+//	cql.Query[models.Job](
+//		ctx,
+//		tx,
+//		conditions.Job.Status.Is().Eq(cql.String("pending")),
+//	).ForUpdate(cql.SkipLocked()).Limit(1).Find()
+func SkipLocked() condition.LockOption {
+	return condition.SkipLocked()
+}
+
+// NoWait makes the query to fail if any of the rows is already locked by other transaction
+// instead of waiting for it to be released
+func NoWait() condition.LockOption {
+	return condition.NoWait()
+}
+
+// LockOf limits the lock to the rows of the model T when other models are joined.
+//
+// For example, to lock the sales but not their products:
+//
+//	cql.Query[models.Sale](
+//		ctx,
+//		tx,
+//		conditions.Sale.Product(),
+//	).ForUpdate(cql.LockOf[models.Sale]())
+func LockOf[T model.Model]() condition.LockOption {
+	return condition.LockOf[T]()
+}
@@ -155,6 +155,40 @@ func (l cqlslog) TraceTransaction(ctx context.Context, begin time.Time) {
 	}
 }
 
+func (l cqlslog) TraceTransactionRetry(ctx context.Context, attempt uint, err error) {
+	if l.LogLevel >= logger.Warn {
+		l.logAttrs(
+			ctx,
+			slog.LevelWarn,
+			"transaction_retry",
+			slog.Uint64("attempt", uint64(attempt)),
+			slog.Any("error", err),
+		)
+	}
+}
+
+func (l cqlslog) TraceSavePoint(ctx context.Context, name string) {
+	if l.LogLevel >= logger.Info {
+		l.logAttrs(
+			ctx,
+			slog.LevelDebug,
+			"savepoint_created",
+			slog.String("savepoint", name),
+		)
+	}
+}
+
+func (l cqlslog) TraceRollbackTo(ctx context.Context, name string) {
+	if l.LogLevel >= logger.Warn {
+		l.logAttrs(
+			ctx,
+			slog.LevelWarn,
+			"savepoint_rollback",
+			slog.String("savepoint", name),
+		)
+	}
+}
+
 // Filter parameters from queries depending of the value of ParameterizedQueries
 func (l cqlslog) ParamsFilter(_ context.Context, sql string, params ...any) (string, []any) {
 	if l.ParameterizedQueries {
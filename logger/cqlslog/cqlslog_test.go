@@ -154,3 +154,58 @@ func TestTraceTransactionExec(t *testing.T) {
 	assert.Contains(t, log, `msg=transaction_exec`)
 	assert.Contains(t, log, "elapsed_time=")
 }
+
+func TestTraceSavePoint(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	slogLogger := slog.New(slog.NewTextHandler(buffer, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}))
+
+	logger := cqlslog.NewDefault(slogLogger).ToLogMode(logger.Info)
+	logger.TraceSavePoint(context.Background(), "my_savepoint")
+
+	reader := bufio.NewReader(buffer)
+	log, err := reader.ReadString('\n')
+	require.NoError(t, err)
+
+	assert.Contains(t, log, "level=DEBUG")
+	assert.Contains(t, log, `msg=savepoint_created`)
+	assert.Contains(t, log, "savepoint=my_savepoint")
+}
+
+func TestTraceRollbackTo(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	slogLogger := slog.New(slog.NewTextHandler(buffer, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}))
+
+	logger := cqlslog.NewDefault(slogLogger)
+	logger.TraceRollbackTo(context.Background(), "my_savepoint")
+
+	reader := bufio.NewReader(buffer)
+	log, err := reader.ReadString('\n')
+	require.NoError(t, err)
+
+	assert.Contains(t, log, "level=WARN")
+	assert.Contains(t, log, `msg=savepoint_rollback`)
+	assert.Contains(t, log, "savepoint=my_savepoint")
+}
+
+func TestTraceTransactionRetry(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	slogLogger := slog.New(slog.NewTextHandler(buffer, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}))
+
+	logger := cqlslog.NewDefault(slogLogger)
+	logger.TraceTransactionRetry(context.Background(), 1, errors.New("deadlock"))
+
+	reader := bufio.NewReader(buffer)
+	log, err := reader.ReadString('\n')
+	require.NoError(t, err)
+
+	assert.Contains(t, log, "level=WARN")
+	assert.Contains(t, log, `msg=transaction_retry`)
+	assert.Contains(t, log, "attempt=1")
+	assert.Contains(t, log, "error=deadlock")
+}
@@ -145,6 +145,34 @@ func (l cqlzap) TraceTransaction(_ context.Context, begin time.Time) {
 	}
 }
 
+func (l cqlzap) TraceTransactionRetry(_ context.Context, attempt uint, err error) {
+	if l.LogLevel >= logger.Warn {
+		l.logger().Warn(
+			"transaction_retry",
+			zap.Uint("attempt", attempt),
+			zap.Error(err),
+		)
+	}
+}
+
+func (l cqlzap) TraceSavePoint(_ context.Context, name string) {
+	if l.LogLevel >= logger.Info {
+		l.logger().Debug(
+			"savepoint_created",
+			zap.String("savepoint", name),
+		)
+	}
+}
+
+func (l cqlzap) TraceRollbackTo(_ context.Context, name string) {
+	if l.LogLevel >= logger.Warn {
+		l.logger().Warn(
+			"savepoint_rollback",
+			zap.String("savepoint", name),
+		)
+	}
+}
+
 // Filter parameters from queries depending of the value of ParameterizedQueries
 func (l cqlzap) ParamsFilter(_ context.Context, sql string, params ...any) (string, []any) {
 	if l.ParameterizedQueries {
@@ -120,3 +120,47 @@ func TestTraceTransactionExec(t *testing.T) {
 	assert.Equal(t, "transaction_exec", log.Message)
 	require.Len(t, log.Context, 1)
 }
+
+func TestTraceSavePoint(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	zapLogger := zap.New(core)
+
+	logger := cqlzap.NewDefault(zapLogger).ToLogMode(logger.Info)
+	logger.TraceSavePoint(context.Background(), "my_savepoint")
+
+	require.Equal(t, 1, logs.Len())
+	log := logs.All()[0]
+	assert.Equal(t, zapcore.DebugLevel, log.Level)
+	assert.Equal(t, "savepoint_created", log.Message)
+	require.Len(t, log.Context, 1)
+	assert.Equal(t, "my_savepoint", log.Context[0].String)
+}
+
+func TestTraceRollbackTo(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	zapLogger := zap.New(core)
+
+	logger := cqlzap.NewDefault(zapLogger)
+	logger.TraceRollbackTo(context.Background(), "my_savepoint")
+
+	require.Equal(t, 1, logs.Len())
+	log := logs.All()[0]
+	assert.Equal(t, zapcore.WarnLevel, log.Level)
+	assert.Equal(t, "savepoint_rollback", log.Message)
+	require.Len(t, log.Context, 1)
+	assert.Equal(t, "my_savepoint", log.Context[0].String)
+}
+
+func TestTraceTransactionRetry(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	zapLogger := zap.New(core)
+
+	logger := cqlzap.NewDefault(zapLogger)
+	logger.TraceTransactionRetry(context.Background(), 1, errors.New("deadlock"))
+
+	require.Equal(t, 1, logs.Len())
+	log := logs.All()[0]
+	assert.Equal(t, zapcore.WarnLevel, log.Level)
+	assert.Equal(t, "transaction_retry", log.Message)
+	require.Len(t, log.Context, 2)
+}
@@ -81,6 +81,24 @@ func (l defaultLogger) TraceTransaction(ctx context.Context, begin time.Time) {
 	}
 }
 
+func (l defaultLogger) TraceTransactionRetry(ctx context.Context, attempt uint, err error) {
+	if l.LogLevel >= Warn {
+		l.Interface.Warn(ctx, "transaction_retry [attempt:%d] %s", attempt, err)
+	}
+}
+
+func (l defaultLogger) TraceSavePoint(ctx context.Context, name string) {
+	if l.LogLevel >= Info {
+		l.Interface.Info(ctx, "savepoint_created [%s]", name)
+	}
+}
+
+func (l defaultLogger) TraceRollbackTo(ctx context.Context, name string) {
+	if l.LogLevel >= Warn {
+		l.Interface.Warn(ctx, "savepoint_rollback [%s]", name)
+	}
+}
+
 type writerWrapper struct {
 	Writer Writer
 }
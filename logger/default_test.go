@@ -90,3 +90,30 @@ func TestTraceTransactionExec(t *testing.T) {
 
 	assert.Contains(t, buffer.String(), "transaction_exec")
 }
+
+func TestTraceSavePoint(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger := logger.NewWithWriter(logger.DefaultConfig, log.New(&buffer, "\r\n", log.LstdFlags)).ToLogMode(logger.Info)
+	logger.TraceSavePoint(context.Background(), "my_savepoint")
+
+	assert.Contains(t, buffer.String(), "savepoint_created [my_savepoint]")
+}
+
+func TestTraceRollbackTo(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger := logger.NewWithWriter(logger.DefaultConfig, log.New(&buffer, "\r\n", log.LstdFlags))
+	logger.TraceRollbackTo(context.Background(), "my_savepoint")
+
+	assert.Contains(t, buffer.String(), "savepoint_rollback [my_savepoint]")
+}
+
+func TestTraceTransactionRetry(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger := logger.NewWithWriter(logger.DefaultConfig, log.New(&buffer, "\r\n", log.LstdFlags))
+	logger.TraceTransactionRetry(context.Background(), 1, errors.New("deadlock"))
+
+	assert.Contains(t, buffer.String(), "transaction_retry [attempt:1] deadlock")
+}
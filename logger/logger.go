@@ -16,6 +16,12 @@ type Interface interface {
 	ToLogMode(level gormLogger.LogLevel) Interface
 	// Trace a committed transaction
 	TraceTransaction(ctx context.Context, begin time.Time)
+	// Trace the retry of a transaction that failed with err in the attempt number attempt
+	TraceTransactionRetry(ctx context.Context, attempt uint, err error)
+	// Trace the creation of a savepoint inside a transaction
+	TraceSavePoint(ctx context.Context, name string)
+	// Trace a rollback to a savepoint (a partial rollback of a transaction)
+	TraceRollbackTo(ctx context.Context, name string)
 }
 
 type (
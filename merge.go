@@ -0,0 +1,41 @@
+package cql
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Merge creates a MERGE statement that merges the results of query (the source)
+// into the table of T (the target), inside transaction tx.
+//
+// Each set defines the value (that can use the fields of the models of query) of a field of T for each row of the source.
+// The type of each value is verified in compilation time, so it must be the same as the type of the field.
+//
+// Then, On defines the fields used to match the rows of the source with the models of T
+// and WhenMatchedUpdate, WhenMatchedDelete and WhenNotMatchedInsert the actions to be taken.
+//
+// For example, to update the description of the archived sales and archive the new ones:
+//
+//	merged, err := cql.Merge[models.SaleArchive](
+//		ctx,
+//		db,
+//		cql.Query[models.Sale](ctx, db),
+//		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+//		conditions.SaleArchive.Description.Set().Eq(conditions.Sale.Description),
+//	).On(
+//		conditions.SaleArchive.Code,
+//	).WhenMatchedUpdate(
+//		conditions.SaleArchive.Description,
+//	).WhenNotMatchedInsert().Exec()
+//
+// Available for: postgres (>= 15), sqlserver
+func Merge[T model.Model](
+	ctx context.Context,
+	tx *DB,
+	query condition.IQuery,
+	sets ...*condition.Set[T],
+) *condition.Merge[T] {
+	return condition.NewMerge(tx.gormDBWithContext(ctx), query, sets)
+}
@@ -0,0 +1,83 @@
+package cql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlserver"
+	"gorm.io/gorm"
+
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+func mergeToSQL(t *testing.T, dialector gorm.Dialector) string {
+	t.Helper()
+
+	db, err := Open(dialector)
+	require.NoError(t, err)
+
+	sql, _, err := Merge[models.SaleArchive](
+		context.Background(),
+		db,
+		Query[models.Sale](
+			context.Background(),
+			db,
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+		conditions.SaleArchive.Description.Set().Eq(String("archived")),
+	).On(
+		conditions.SaleArchive.Code,
+	).WhenMatchedUpdate(
+		conditions.SaleArchive.Description,
+	).WhenNotMatchedInsert().ToSQL()
+	require.NoError(t, err)
+
+	return sql
+}
+
+func TestMergeToSQLPostgres(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	mock.ExpectQuery("SHOW server_version").
+		WillReturnRows(sqlmock.NewRows([]string{"server_version"}).AddRow("16.2"))
+
+	sql := mergeToSQL(t, postgres.New(postgres.Config{
+		Conn: conn,
+	}))
+
+	// postgres can not infer the type of the values of the source, so they are cast
+	assert.Contains(t, sql, `MERGE INTO "sale_archives" AS cql_target USING (SELECT CAST(sales.code AS bigint) AS "code", CAST($1 AS text) AS "description"`)
+	assert.Contains(t, sql, `ON "cql_target"."code" = "cql_source"."code"`)
+	assert.Contains(t, sql, `WHEN MATCHED AND "cql_target"."deleted_at" IS NULL THEN UPDATE SET "description" = "cql_source"."description"`)
+	assert.Contains(t, sql, `WHEN NOT MATCHED THEN INSERT ("code","description","created_at","updated_at")`)
+	assert.NotContains(t, sql, ";")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMergeToSQLSQLServer(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	sql := mergeToSQL(t, sqlserver.New(sqlserver.Config{
+		Conn: conn,
+	}))
+
+	assert.Contains(t, sql, `MERGE INTO "sale_archives" AS cql_target USING (SELECT sales.code AS "code", @p1 AS "description"`)
+	assert.Contains(t, sql, `ON "cql_target"."code" = "cql_source"."code"`)
+	assert.Contains(t, sql, `WHEN MATCHED AND "cql_target"."deleted_at" IS NULL THEN UPDATE SET "description" = "cql_source"."description"`)
+	assert.Contains(t, sql, `WHEN NOT MATCHED THEN INSERT ("code","description","created_at","updated_at")`)
+	// sqlserver requires MERGE statements to be terminated by a semicolon
+	assert.True(t, strings.HasSuffix(sql, ";"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
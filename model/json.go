@@ -0,0 +1,63 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var ErrInvalidJSONScan = errors.New("failed to scan json value")
+
+// JSON document, stored as jsonb in postgres, JSON in mysql and sqlite and nvarchar(max) in sqlserver
+type JSON json.RawMessage
+
+func (j JSON) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "mysql", "sqlite":
+		return "JSON"
+	case "postgres":
+		return "jsonb"
+	case "sqlserver":
+		return "nvarchar(max)"
+	}
+
+	return ""
+}
+
+func (j JSON) Value() (driver.Value, error) {
+	if len(j) == 0 {
+		return nil, nil
+	}
+
+	return string(j), nil
+}
+
+func (j *JSON) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*j = nil
+	case []byte:
+		*j = append((*j)[0:0], value...)
+	case string:
+		*j = JSON(value)
+	default:
+		return ErrInvalidJSONScan
+	}
+
+	return nil
+}
+
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return json.RawMessage(j).MarshalJSON()
+}
+
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	return (*json.RawMessage)(j).UnmarshalJSON(data)
+}
+
+func (j JSON) String() string {
+	return string(j)
+}
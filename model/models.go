@@ -4,15 +4,27 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
-// supported types for model identifier
+// Type of a model identifier
+//
+// cql provides UUID, UIntID, Int64ID and StringID,
+// but any other type (ULID, etc.) that implements IsNil can be used as identifier with IDModel
 type ID interface {
-	UIntID | UUID
-
+	// IsNil returns true if the identifier is not set
 	IsNil() bool
 }
 
+// Identifier that is generated when a model that uses it (through IDModel or IDModelWithTimestamps)
+// is created without identifier
+type GeneratedID[TID ID] interface {
+	ID
+
+	// Generate returns a new identifier
+	Generate() TID
+}
+
 type Model interface {
 	IsLoaded() bool
 	SoftDeleteColumnName() string
@@ -22,7 +34,7 @@ type Model interface {
 // Base Model for cql with uuid as id and timestamps for creation, edition and deletion (soft-delete)
 //
 // Every model intended to be saved in the database must embed
-// UUIDModel, UUIDModelWithTimestamps, UIntModel or UIntModelWithTimestamps
+// one of the base models of this package
 // reference: https://gorm.io/docs/models.html#gorm-Model
 type UUIDModel struct {
 	ID UUID `gorm:"primarykey;not null"`
@@ -42,7 +54,7 @@ func (model UUIDModel) UpdatedAtColumnName() string {
 
 func (model *UUIDModel) BeforeCreate(_ *gorm.DB) (err error) {
 	if model.ID == NilUUID {
-		model.ID = NewUUID()
+		model.ID = generateUUID()
 	}
 
 	return nil
@@ -51,7 +63,7 @@ func (model *UUIDModel) BeforeCreate(_ *gorm.DB) (err error) {
 // Base Model for cql with uuid as id and timestamps for creation, edition and deletion (soft-delete)
 //
 // Every model intended to be saved in the database must embed
-// UUIDModel, UUIDModelWithTimestamps, UIntModel or UIntModelWithTimestamps
+// one of the base models of this package
 // reference: https://gorm.io/docs/models.html#gorm-Model
 type UUIDModelWithTimestamps struct {
 	ID        UUID `gorm:"primarykey;not null"`
@@ -74,7 +86,69 @@ func (model UUIDModelWithTimestamps) UpdatedAtColumnName() string {
 
 func (model *UUIDModelWithTimestamps) BeforeCreate(_ *gorm.DB) (err error) {
 	if model.ID == NilUUID {
-		model.ID = NewUUID()
+		model.ID = generateUUID()
+	}
+
+	return nil
+}
+
+// Base Model for cql with a time-ordered (version 7) uuid as id
+//
+// Every model intended to be saved in the database must embed
+// one of the base models of this package
+// reference: https://gorm.io/docs/models.html#gorm-Model
+type UUIDv7Model struct {
+	ID UUID `gorm:"primarykey;not null"`
+}
+
+func (model UUIDv7Model) IsLoaded() bool {
+	return !model.ID.IsNil()
+}
+
+func (model UUIDv7Model) SoftDeleteColumnName() string {
+	return ""
+}
+
+func (model UUIDv7Model) UpdatedAtColumnName() string {
+	return ""
+}
+
+func (model *UUIDv7Model) BeforeCreate(_ *gorm.DB) (err error) {
+	if model.ID == NilUUID {
+		model.ID = NewUUIDv7()
+	}
+
+	return nil
+}
+
+// Base Model for cql with a time-ordered (version 7) uuid as id
+// and timestamps for creation, edition and deletion (soft-delete)
+//
+// Every model intended to be saved in the database must embed
+// one of the base models of this package
+// reference: https://gorm.io/docs/models.html#gorm-Model
+type UUIDv7ModelWithTimestamps struct {
+	ID        UUID `gorm:"primarykey;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (model UUIDv7ModelWithTimestamps) IsLoaded() bool {
+	return !model.ID.IsNil()
+}
+
+func (model UUIDv7ModelWithTimestamps) SoftDeleteColumnName() string {
+	return "deleted_at"
+}
+
+func (model UUIDv7ModelWithTimestamps) UpdatedAtColumnName() string {
+	return "updated_at"
+}
+
+func (model *UUIDv7ModelWithTimestamps) BeforeCreate(_ *gorm.DB) (err error) {
+	if model.ID == NilUUID {
+		model.ID = NewUUIDv7()
 	}
 
 	return nil
@@ -91,7 +165,7 @@ func (id UIntID) IsNil() bool {
 // Base Model for cql with uint as id
 //
 // Every model intended to be saved in the database must embed
-// UUIDModel, UUIDModelWithTimestamps, UIntModel or UIntModelWithTimestamps
+// one of the base models of this package
 // reference: https://gorm.io/docs/models.html#gorm-Model
 type UIntModel struct {
 	ID UIntID `gorm:"primarykey;not null"`
@@ -112,7 +186,7 @@ func (model UIntModel) UpdatedAtColumnName() string {
 // Base Model for cql with uint as id and timestamps for creation, edition and deletion (soft-delete)
 //
 // Every model intended to be saved in the database must embed
-// UUIDModel, UUIDModelWithTimestamps, UIntModel or UIntModelWithTimestamps
+// one of the base models of this package
 // reference: https://gorm.io/docs/models.html#gorm-Model
 type UIntModelWithTimestamps struct {
 	ID        UIntID `gorm:"primarykey;not null"`
@@ -132,3 +206,248 @@ func (model UIntModelWithTimestamps) SoftDeleteColumnName() string {
 func (model UIntModelWithTimestamps) UpdatedAtColumnName() string {
 	return "updated_at"
 }
+
+type Int64ID int64
+
+const NilInt64ID = 0
+
+func (id Int64ID) IsNil() bool {
+	return id == NilInt64ID
+}
+
+// Base Model for cql with int64 as id (bigint auto-incremental identity column)
+//
+// Every model intended to be saved in the database must embed
+// one of the base models of this package
+// reference: https://gorm.io/docs/models.html#gorm-Model
+type Int64Model struct {
+	ID Int64ID `gorm:"primarykey;not null"`
+}
+
+func (model Int64Model) IsLoaded() bool {
+	return !model.ID.IsNil()
+}
+
+func (model Int64Model) SoftDeleteColumnName() string {
+	return ""
+}
+
+func (model Int64Model) UpdatedAtColumnName() string {
+	return ""
+}
+
+// Base Model for cql with int64 as id (bigint auto-incremental identity column)
+// and timestamps for creation, edition and deletion (soft-delete)
+//
+// Every model intended to be saved in the database must embed
+// one of the base models of this package
+// reference: https://gorm.io/docs/models.html#gorm-Model
+type Int64ModelWithTimestamps struct {
+	ID        Int64ID `gorm:"primarykey;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (model Int64ModelWithTimestamps) IsLoaded() bool {
+	return !model.ID.IsNil()
+}
+
+func (model Int64ModelWithTimestamps) SoftDeleteColumnName() string {
+	return "deleted_at"
+}
+
+func (model Int64ModelWithTimestamps) UpdatedAtColumnName() string {
+	return "updated_at"
+}
+
+// Identifier set by the user (external identifiers, codes, etc.)
+type StringID string
+
+const NilStringID = ""
+
+func (id StringID) IsNil() bool {
+	return id == NilStringID
+}
+
+// maximum length of a StringID
+const stringIDSize = "255"
+
+func (id StringID) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	// a size is set so it can be used as primary and foreign key in every database
+	switch db.Dialector.Name() {
+	case "mysql", "postgres", "sqlite":
+		return "varchar(" + stringIDSize + ")"
+	case "sqlserver":
+		return "nvarchar(" + stringIDSize + ")"
+	}
+
+	return ""
+}
+
+// Base Model for cql with a string as id, that must be set before the model is created
+//
+// Every model intended to be saved in the database must embed
+// one of the base models of this package
+// reference: https://gorm.io/docs/models.html#gorm-Model
+type StringModel struct {
+	ID StringID `gorm:"primarykey;not null"`
+}
+
+func (model StringModel) IsLoaded() bool {
+	return !model.ID.IsNil()
+}
+
+func (model StringModel) SoftDeleteColumnName() string {
+	return ""
+}
+
+func (model StringModel) UpdatedAtColumnName() string {
+	return ""
+}
+
+// Base Model for cql with a string as id, that must be set before the model is created,
+// and timestamps for creation, edition and deletion (soft-delete)
+//
+// Every model intended to be saved in the database must embed
+// one of the base models of this package
+// reference: https://gorm.io/docs/models.html#gorm-Model
+type StringModelWithTimestamps struct {
+	ID        StringID `gorm:"primarykey;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (model StringModelWithTimestamps) IsLoaded() bool {
+	return !model.ID.IsNil()
+}
+
+func (model StringModelWithTimestamps) SoftDeleteColumnName() string {
+	return "deleted_at"
+}
+
+func (model StringModelWithTimestamps) UpdatedAtColumnName() string {
+	return "updated_at"
+}
+
+// Base Model for cql with a custom type as id (ULID, etc.)
+//
+// If TID implements GeneratedID, the id is generated when the model is created without id.
+// Otherwise, it must be set before the model is created.
+type IDModel[TID ID] struct {
+	ID TID `gorm:"primarykey;not null"`
+}
+
+func (model IDModel[TID]) IsLoaded() bool {
+	return !model.ID.IsNil()
+}
+
+func (model IDModel[TID]) SoftDeleteColumnName() string {
+	return ""
+}
+
+func (model IDModel[TID]) UpdatedAtColumnName() string {
+	return ""
+}
+
+func (model *IDModel[TID]) BeforeCreate(_ *gorm.DB) (err error) {
+	model.ID = generateID(model.ID)
+
+	return nil
+}
+
+// Base Model for cql with a custom type as id (ULID, etc.)
+// and timestamps for creation, edition and deletion (soft-delete)
+//
+// If TID implements GeneratedID, the id is generated when the model is created without id.
+// Otherwise, it must be set before the model is created.
+type IDModelWithTimestamps[TID ID] struct {
+	ID        TID `gorm:"primarykey;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (model IDModelWithTimestamps[TID]) IsLoaded() bool {
+	return !model.ID.IsNil()
+}
+
+func (model IDModelWithTimestamps[TID]) SoftDeleteColumnName() string {
+	return "deleted_at"
+}
+
+func (model IDModelWithTimestamps[TID]) UpdatedAtColumnName() string {
+	return "updated_at"
+}
+
+func (model *IDModelWithTimestamps[TID]) BeforeCreate(_ *gorm.DB) (err error) {
+	model.ID = generateID(model.ID)
+
+	return nil
+}
+
+// Returns a new id if id is nil and TID implements GeneratedID, or id otherwise
+func generateID[TID ID](id TID) TID {
+	if !id.IsNil() {
+		return id
+	}
+
+	if generatedID, isGenerated := any(id).(GeneratedID[TID]); isGenerated {
+		return generatedID.Generate()
+	}
+
+	return id
+}
+
+// Base Model for cql with a composite primary key
+//
+// The fields of the primary key must be declared in the model with the gorm tag primaryKey
+// and the model must implement IsLoaded, returning true when the primary key is set.
+// reference: https://gorm.io/docs/composite_primary_key.html
+type CompositeKeyModel struct{}
+
+func (model CompositeKeyModel) SoftDeleteColumnName() string {
+	return ""
+}
+
+func (model CompositeKeyModel) UpdatedAtColumnName() string {
+	return ""
+}
+
+// Base Model for cql with a composite primary key and timestamps for creation, edition and deletion (soft-delete)
+//
+// The fields of the primary key must be declared in the model with the gorm tag primaryKey
+// and the model must implement IsLoaded, returning true when the primary key is set.
+// reference: https://gorm.io/docs/composite_primary_key.html
+type CompositeKeyModelWithTimestamps struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (model CompositeKeyModelWithTimestamps) SoftDeleteColumnName() string {
+	return "deleted_at"
+}
+
+func (model CompositeKeyModelWithTimestamps) UpdatedAtColumnName() string {
+	return "updated_at"
+}
+
+// Model that uses optimistic locking
+type VersionedModel interface {
+	VersionColumnName() string
+}
+
+// Versioned adds optimistic locking to a model when embedded in it (alongside its base model)
+//
+// The Version column is incremented by each update made with cql.Update
+// and it is verified by cql.UpdateModel and cql.DeleteModel,
+// returning cql.ErrStaleObject if the model was modified since it was loaded
+type Versioned struct {
+	Version uint `gorm:"not null;default:0"`
+}
+
+func (versioned Versioned) VersionColumnName() string {
+	return "version"
+}
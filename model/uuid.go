@@ -3,6 +3,10 @@ package model
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -14,6 +18,33 @@ type UUID uuid.UUID
 
 var NilUUID = UUID(uuid.Nil)
 
+var ErrNotUUIDv7 = errors.New("uuid is not a version 7 uuid")
+
+// Function used to generate the id of UUIDModel and UUIDModelWithTimestamps
+// when they are created without id (NewUUID if not set)
+var uuidGenerator atomic.Pointer[func() UUID]
+
+// SetUUIDGenerator sets the function used to generate the id of
+// UUIDModel and UUIDModelWithTimestamps when they are created without id
+// (NewUUID, a random (version 4) UUID, by default).
+//
+// For example, SetUUIDGenerator(model.NewUUIDv7) makes all these models use time-ordered UUIDs.
+// It is meant to be called during initialization or in tests:
+// it is safe for concurrent use, but models created concurrently may use either generator.
+func SetUUIDGenerator(generator func() UUID) {
+	uuidGenerator.Store(&generator)
+}
+
+// Generates the id of UUIDModel and UUIDModelWithTimestamps
+// using the generator set by SetUUIDGenerator
+func generateUUID() UUID {
+	if generator := uuidGenerator.Load(); generator != nil {
+		return (*generator)()
+	}
+
+	return NewUUID()
+}
+
 func (id UUID) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
 	switch db.Dialector.Name() {
 	case "mysql":
@@ -104,6 +135,57 @@ func NewUUID() UUID {
 	return UUID(uuid.New())
 }
 
+// NewUUIDv7 returns a new time-ordered (version 7) UUID,
+// whose first 48 bits are the current unix timestamp in milliseconds
+func NewUUIDv7() UUID {
+	return UUID(uuid.Must(uuid.NewV7()))
+}
+
+// Timestamp returns the time (with millisecond precision) in which a version 7 UUID was generated,
+// or ErrNotUUIDv7 if the UUID is not of version 7
+func (id UUID) Timestamp() (time.Time, error) {
+	if id.Version() != uuidV7 {
+		return time.Time{}, ErrNotUUIDv7
+	}
+
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(id[:8]) >> uuidV7TimestampShift)), nil
+}
+
+// MinUUIDv7 returns the smallest version 7 UUID that can be generated at time t
+// (with millisecond precision), useful to search UUIDs generated after t
+func MinUUIDv7(t time.Time) UUID {
+	return newUUIDv7WithRandom(t, 0x00)
+}
+
+// MaxUUIDv7 returns the greatest version 7 UUID that can be generated at time t
+// (with millisecond precision), useful to search UUIDs generated before t
+func MaxUUIDv7(t time.Time) UUID {
+	return newUUIDv7WithRandom(t, 0xff)
+}
+
+const (
+	uuidV7               = 7
+	uuidV7TimestampShift = 16 // the 48 bits timestamp is followed by 16 bits of version and random data
+	uuidVariantMask      = 0x3f
+	uuidVariantRFC4122   = 0x80
+)
+
+// Returns a version 7 UUID with the timestamp of t and all its random bits set to randomByte
+func newUUIDv7WithRandom(t time.Time, randomByte byte) UUID {
+	var id UUID
+
+	binary.BigEndian.PutUint64(id[:8], uint64(t.UnixMilli())<<uuidV7TimestampShift)
+
+	for i := 6; i < len(id); i++ {
+		id[i] = randomByte
+	}
+
+	id[6] = (id[6] & 0x0f) | (uuidV7 << 4)
+	id[8] = (id[8] & uuidVariantMask) | uuidVariantRFC4122
+
+	return id
+}
+
 func ParseUUID(s string) (UUID, error) {
 	uid, err := uuid.Parse(s)
 	if err != nil {
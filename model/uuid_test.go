@@ -1,7 +1,9 @@
 package model_test
 
 import (
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -22,3 +24,76 @@ func TestParseIncorrectUUID(t *testing.T) {
 	require.Error(t, err)
 	assert.Equal(t, model.NilUUID, uid)
 }
+
+func TestNewUUIDv7HasGenerationTimestamp(t *testing.T) {
+	before := time.Now().Truncate(time.Millisecond)
+	uid := model.NewUUIDv7()
+	after := time.Now()
+
+	assert.Equal(t, uuid.Version(7), uid.Version())
+
+	timestamp, err := uid.Timestamp()
+	require.NoError(t, err)
+	assert.False(t, timestamp.Before(before))
+	assert.False(t, timestamp.After(after))
+}
+
+func TestNewUUIDv7IsTimeOrdered(t *testing.T) {
+	first := model.NewUUIDv7()
+
+	time.Sleep(2 * time.Millisecond)
+
+	second := model.NewUUIDv7()
+
+	assert.Less(t, first.String(), second.String())
+}
+
+func TestTimestampOfNotUUIDv7ReturnsError(t *testing.T) {
+	_, err := model.NewUUID().Timestamp()
+	require.ErrorIs(t, err, model.ErrNotUUIDv7)
+}
+
+func TestMinAndMaxUUIDv7(t *testing.T) {
+	generationTime := time.UnixMilli(1700000000123)
+
+	minUUID := model.MinUUIDv7(generationTime)
+	maxUUID := model.MaxUUIDv7(generationTime)
+
+	assert.Equal(t, "018bcfe5-687b-7000-8000-000000000000", minUUID.String())
+	assert.Equal(t, "018bcfe5-687b-7fff-bfff-ffffffffffff", maxUUID.String())
+
+	for _, uid := range []model.UUID{minUUID, maxUUID} {
+		assert.Equal(t, uuid.Version(7), uid.Version())
+		assert.Equal(t, uuid.RFC4122, uid.Variant())
+
+		timestamp, err := uid.Timestamp()
+		require.NoError(t, err)
+		assert.True(t, generationTime.Equal(timestamp))
+	}
+}
+
+func TestSetUUIDGeneratorIsSafeForConcurrentUse(t *testing.T) {
+	defer model.SetUUIDGenerator(model.NewUUID)
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			model.SetUUIDGenerator(model.NewUUIDv7)
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			uuidModel := model.UUIDModel{}
+			assert.NoError(t, uuidModel.BeforeCreate(nil))
+			assert.False(t, uuidModel.ID.IsNil())
+		}()
+	}
+
+	wg.Wait()
+}
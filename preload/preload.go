@@ -1,6 +1,8 @@
 package preload
 
 import (
+	"reflect"
+
 	"github.com/FrancoLiberali/cql/model"
 )
 
@@ -32,6 +34,30 @@ func VerifyPointerWithIDLoaded[TModel model.Model, TID model.ID](id TID, toVerif
 	return toVerify, nil
 }
 
+// VerifyPointerWithKeyLoaded verifies a relation made with a composite foreign key,
+// where key are the values of the fields of the foreign key
+func VerifyPointerWithKeyLoaded[TModel model.Model](toVerify *TModel, key ...any) (*TModel, error) {
+	// when the pointer to the object is nil
+	// but the key indicates that the relation is not nil
+	if !isNilKey(key) && toVerify == nil {
+		return nil, ErrRelationNotLoaded
+	}
+
+	return toVerify, nil
+}
+
+// Returns true if all the values of the key are nil or zero values
+func isNilKey(key []any) bool {
+	for _, value := range key {
+		reflectValue := reflect.ValueOf(value)
+		if reflectValue.IsValid() && !reflectValue.IsZero() {
+			return false
+		}
+	}
+
+	return true
+}
+
 func VerifyCollectionLoaded[T model.Model](collection *[]T) ([]T, error) {
 	if collection == nil {
 		return nil, ErrRelationNotLoaded
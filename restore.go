@@ -0,0 +1,27 @@
+package cql
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Create a Restore of the soft deleted models that match the conditions, inside transaction tx.
+// Restoring a model sets its deleted at column to null, so it is returned again by queries.
+//
+// If the model does not support soft delete, cql.ErrNotSoftDeletable is returned.
+//
+// For example:
+//
+//	restored, err := cql.Restore[models.Product](
+//		ctx,
+//		db,
+//		conditions.Product.Code.Is().Eq(cql.Int(1)),
+//	).Exec()
+func Restore[T model.Model](ctx context.Context, tx *DB, conditions ...condition.Condition[T]) *condition.Restore[T] {
+	return condition.NewRestore(
+		tx.gormDBWithContext(ctx),
+		conditions,
+	)
+}
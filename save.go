@@ -0,0 +1,65 @@
+package cql
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Save persists entity inside transaction tx.
+//
+// If entity is not loaded (its primary key is empty), it is inserted.
+// In other case, an UPDATE of all its fields is executed, also setting its updated at column.
+// Save does not know which fields changed, so every field is written, even the ones that did not change,
+// overwriting the changes made to them by other transactions since entity was loaded.
+// To update only the fields that changed since it was loaded, use cql.SaveTracked.
+//
+// If entity uses optimistic locking (embeds model.Versioned), its version is also verified,
+// so cql.ErrStaleObject is returned if it was modified by other transaction since it was loaded.
+//
+// cql.Cascade can be used to also save the relations of entity that were preloaded.
+//
+// For details see https://compiledquerylenguage.readthedocs.io/en/latest/cql/update.html#save
+func Save[T model.Model](ctx context.Context, tx *DB, entity *T, options ...condition.SaveOption) error {
+	return condition.Save(tx.gormDBWithContext(ctx), entity, options...)
+}
+
+// SaveTracked persists the changes made to a model obtained using FindTracked or FindOneTracked
+// inside transaction tx, executing an UPDATE of only the fields that changed since it was loaded,
+// also setting its updated at column.
+//
+// If the model uses optimistic locking (embeds model.Versioned), its version is also verified,
+// so cql.ErrStaleObject is returned if it was modified by other transaction since it was loaded.
+//
+// cql.Cascade can be used to also save the changes made to the relations of the model that were preloaded.
+//
+// For example:
+//
+//	sale, err := cql.Query[models.Sale](
+//		ctx,
+//		db,
+//		conditions.Sale.Code.Is().Eq(cql.Int(1)),
+//		conditions.Sale.Product().Preload(),
+//	).FindOneTracked()
+//
+//	sale.Model.Description = "new description"
+//	sale.Model.Product.Int = 2
+//
+//	err = cql.SaveTracked(ctx, db, sale, cql.Cascade())
+//
+// For details see https://compiledquerylenguage.readthedocs.io/en/latest/cql/update.html#save
+func SaveTracked[T model.Model](
+	ctx context.Context,
+	tx *DB,
+	tracked *condition.Tracked[T],
+	options ...condition.SaveOption,
+) error {
+	return condition.SaveTracked(tx.gormDBWithContext(ctx), tracked, options...)
+}
+
+// Cascade makes Save to also persist the changes made to the relations of the model
+// that were preloaded, recursively
+func Cascade() condition.SaveOption {
+	return condition.Cascade()
+}
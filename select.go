@@ -71,3 +71,32 @@ func Select[TResults any](
 		selections,
 	)
 }
+
+// SelectQuery creates a query that selects fields into a list of TResults, in the same way as Select,
+// but that is only executed when Find is called.
+//
+// It allows to combine the results of queries with the same type of results
+// using Union, UnionAll, Intersect and Except, for example:
+//
+//	results, err := cql.SelectQuery(
+//		cql.Query[models.Sale](ctx, db, conditions.Sale.Code.Is().Eq(cql.Int(1))),
+//		cql.ValueInto(conditions.Sale.Code, func(value float64, result *Result) {
+//			result.Code = int(value)
+//		}),
+//	).Union(
+//		cql.SelectQuery(
+//			cql.Query[models.Sale](ctx, db, conditions.Sale.Code.Is().Eq(cql.Int(2))),
+//			cql.ValueInto(conditions.Sale.Code, func(value float64, result *Result) {
+//				result.Code = int(value)
+//			}),
+//		),
+//	).Find()
+func SelectQuery[TResults any](
+	query condition.IQuery,
+	selections ...condition.Selection[TResults],
+) *condition.SelectQuery[TResults] {
+	return condition.NewSelectQuery(
+		query,
+		selections,
+	)
+}
@@ -1,6 +1,9 @@
 package sql
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 type Function interface {
 	ApplyTo(internalSQL string, values []string) string
@@ -52,6 +55,16 @@ func (f PreOperatorFunction) ApplyTo(internalSQL string, _ []string) string {
 	return f.sqlOperator + internalSQL
 }
 
+// Function whose sql is defined by a format, where the first %s is replaced by the internal sql
+// and the second one by the values, separated by commas
+type FormatFunction struct {
+	format string
+}
+
+func (f FormatFunction) ApplyTo(internalSQL string, values []string) string {
+	return fmt.Sprintf(f.format, internalSQL, strings.Join(values, ", "))
+}
+
 type FunctionByDialector struct {
 	functions map[Dialector]Function
 	Name      string
@@ -152,6 +165,29 @@ var (
 		Name: "Or",
 	}
 
+	// Window
+
+	RowNumber = FunctionByDialector{
+		functions: map[Dialector]Function{all: FunctionFunction{sqlPrefix: "ROW_NUMBER()"}}, //nolint:exhaustive // all present
+		Name:      "RowNumber",
+	}
+	Rank = FunctionByDialector{
+		functions: map[Dialector]Function{all: FunctionFunction{sqlPrefix: "RANK()"}}, //nolint:exhaustive // all present
+		Name:      "Rank",
+	}
+	DenseRank = FunctionByDialector{
+		functions: map[Dialector]Function{all: FunctionFunction{sqlPrefix: "DENSE_RANK()"}}, //nolint:exhaustive // all present
+		Name:      "DenseRank",
+	}
+	Lag = FunctionByDialector{
+		functions: map[Dialector]Function{all: FunctionFunction{sqlFunction: "LAG"}}, //nolint:exhaustive // all present
+		Name:      "Lag",
+	}
+	Lead = FunctionByDialector{
+		functions: map[Dialector]Function{all: FunctionFunction{sqlFunction: "LEAD"}}, //nolint:exhaustive // all present
+		Name:      "Lead",
+	}
+
 	// Bool
 	All = FunctionByDialector{
 		functions: map[Dialector]Function{
@@ -182,6 +218,61 @@ var (
 	}
 )
 
+// JSON
+// refs:
+// - MySQL: https://dev.mysql.com/doc/refman/8.0/en/json-functions.html
+// - PostgreSQL: https://www.postgresql.org/docs/current/functions-json.html
+// - SQLServer: https://learn.microsoft.com/en-us/sql/relational-databases/json/json-data-sql-server?view=sql-server-ver16
+// - SQLite: https://www.sqlite.org/json1.html
+//
+// postgres functions receive each key of the path as a value, the others a json path ($."key1"."key2")
+var (
+	JSONString = FunctionByDialector{
+		functions: map[Dialector]Function{
+			Postgres:  FormatFunction{format: "jsonb_extract_path_text(CAST(%s AS jsonb), %s)"},
+			MySQL:     FormatFunction{format: "JSON_UNQUOTE(JSON_EXTRACT(%s, %s))"},
+			SQLServer: FormatFunction{format: "JSON_VALUE(%s, %s)"},
+			SQLite:    FormatFunction{format: "json_extract(%s, %s)"},
+		},
+		Name: "AsString",
+	}
+	JSONNumeric = FunctionByDialector{
+		functions: map[Dialector]Function{
+			Postgres:  FormatFunction{format: "CAST(jsonb_extract_path_text(CAST(%s AS jsonb), %s) AS DOUBLE PRECISION)"},
+			MySQL:     FormatFunction{format: "CAST(JSON_UNQUOTE(JSON_EXTRACT(%s, %s)) AS DOUBLE)"},
+			SQLServer: FormatFunction{format: "CAST(JSON_VALUE(%s, %s) AS FLOAT)"},
+			SQLite:    FormatFunction{format: "json_extract(%s, %s)"},
+		},
+		Name: "AsNumeric",
+	}
+	JSONBool = FunctionByDialector{
+		functions: map[Dialector]Function{
+			Postgres:  FormatFunction{format: "CAST(jsonb_extract_path_text(CAST(%s AS jsonb), %s) AS BOOLEAN)"},
+			MySQL:     FormatFunction{format: "(JSON_EXTRACT(%s, %s) = CAST('true' AS JSON))"},
+			SQLServer: FormatFunction{format: "CAST(JSON_VALUE(%s, %s) AS BIT)"},
+			SQLite:    FormatFunction{format: "json_extract(%s, %s)"},
+		},
+		Name: "AsBool",
+	}
+	JSONContains = FunctionByDialector{
+		// sqlite and sqlserver do not have a function to compare json documents
+		functions: map[Dialector]Function{ //nolint:exhaustive // supported
+			Postgres: FormatFunction{format: "(CAST(%s AS jsonb) @> CAST(%s AS jsonb))"},
+			MySQL:    FormatFunction{format: "JSON_CONTAINS(%s, %s)"},
+		},
+		Name: "Contains",
+	}
+	JSONHasKey = FunctionByDialector{
+		functions: map[Dialector]Function{
+			Postgres:  FormatFunction{format: "(jsonb_extract_path(CAST(%s AS jsonb), %s) IS NOT NULL)"},
+			MySQL:     FormatFunction{format: "JSON_CONTAINS_PATH(%s, 'one', %s)"},
+			SQLServer: FormatFunction{format: "JSON_PATH_EXISTS(%s, %s)"},
+			SQLite:    FormatFunction{format: "(json_type(%s, %s) IS NOT NULL)"},
+		},
+		Name: "HasKey",
+	}
+)
+
 func (f FunctionByDialector) Get(dialector Dialector) (Function, bool) {
 	dialectorFunc, dialectorPresent := f.functions[dialector]
 
@@ -0,0 +1,40 @@
+package cql
+
+import (
+	"github.com/FrancoLiberali/cql/condition"
+)
+
+// Subquery allows the use of the result of a query that selects a single value
+// as the value of an operator.
+//
+// The type of the selected value is verified in compilation time,
+// so it must be the same as the type of the compared field.
+//
+// It can be used as a scalar value (the subquery must return a single row):
+//
+//	cql.Query[models.Product](
+//		ctx,
+//		db,
+//		conditions.Product.Float.Is().Gt(
+//			cql.Subquery(
+//				cql.Query[models.Product](ctx, db),
+//				conditions.Product.Float.Aggregate().Average(),
+//			),
+//		),
+//	)
+//
+// or as the list of values of In and NotIn:
+//
+//	cql.Query[models.Seller](
+//		ctx,
+//		db,
+//		conditions.Seller.ID.Is().In(
+//			cql.Subquery(
+//				cql.Query[models.Sale](ctx, db, conditions.Sale.Code.Is().Eq(cql.Int(1))),
+//				conditions.Sale.SellerID,
+//			),
+//		),
+//	)
+func Subquery[TValue any](query condition.IQuery, value condition.ValueOfType[TValue]) condition.Subquery[TValue] {
+	return condition.NewSubquery(query, value)
+}
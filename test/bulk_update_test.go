@@ -0,0 +1,293 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/FrancoLiberali/cql"
+	cqlSQL "github.com/FrancoLiberali/cql/sql"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type BulkUpdateIntTestSuite struct {
+	testSuite
+}
+
+func NewBulkUpdateIntTestSuite(
+	db *cql.DB,
+) *BulkUpdateIntTestSuite {
+	return &BulkUpdateIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *BulkUpdateIntTestSuite) TestBulkUpdateWithoutModels() {
+	updated, err := cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		[]*models.Product{},
+		conditions.Product.Int,
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(0), updated)
+}
+
+func (ts *BulkUpdateIntTestSuite) TestBulkUpdateUpdatesEachModelWithItsOwnValues() {
+	product1 := ts.createProduct("product1", 1, 1, false, nil)
+	product2 := ts.createProduct("product2", 2, 2, false, nil)
+	product3 := ts.createProduct("product3", 3, 3, false, nil)
+
+	updatedAtBefore := product1.UpdatedAt
+
+	product1.Int = 10
+	product1.String = "updated1"
+	product1.Float = 100
+	product2.Int = 20
+	product2.String = "updated2"
+	product2.Float = 200
+
+	updated, err := cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		[]*models.Product{product1, product2},
+		conditions.Product.Int,
+		conditions.Product.String,
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(2), updated)
+	ts.True(product1.UpdatedAt.After(updatedAtBefore))
+
+	products, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Gt(cql.Int(5)),
+	).Descending(conditions.Product.Int).Find()
+	ts.Require().NoError(err)
+	ts.Require().Len(products, 2)
+
+	ts.Equal(product2.ID, products[0].ID)
+	ts.Equal(20, products[0].Int)
+	ts.Equal("updated2", products[0].String)
+	ts.Equal(float64(2), products[0].Float)
+	ts.Equal(product1.ID, products[1].ID)
+	ts.Equal(10, products[1].Int)
+	ts.Equal("updated1", products[1].String)
+	ts.Equal(float64(1), products[1].Float)
+	ts.True(products[1].UpdatedAt.After(updatedAtBefore))
+
+	product3Returned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.ID.Is().Eq(cql.UUID(product3.ID)),
+	).FindOne()
+	ts.Require().NoError(err)
+	ts.Equal(3, product3Returned.Int)
+	ts.Equal("product3", product3Returned.String)
+}
+
+func (ts *BulkUpdateIntTestSuite) TestBulkUpdateNullableAndEmbeddedFields() {
+	intP := 1
+	product1 := ts.createProduct("", 1, 0, false, &intP)
+	product2 := ts.createProduct("", 2, 0, false, nil)
+
+	newIntP := 2
+	product1.IntPointer = nil
+	product1.NullFloat = sql.NullFloat64{Valid: true, Float64: 1.5}
+	product1.GormEmbedded.Int = 10
+	product2.IntPointer = &newIntP
+	product2.GormEmbedded.Int = 20
+
+	updated, err := cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		[]*models.Product{product1, product2},
+		conditions.Product.IntPointer,
+		conditions.Product.NullFloat,
+		conditions.Product.GormEmbeddedInt,
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(2), updated)
+
+	products, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Ascending(conditions.Product.Int).Find()
+	ts.Require().NoError(err)
+	ts.Require().Len(products, 2)
+
+	ts.Nil(products[0].IntPointer)
+	ts.Equal(sql.NullFloat64{Valid: true, Float64: 1.5}, products[0].NullFloat)
+	ts.Equal(10, products[0].GormEmbedded.Int)
+	ts.Require().NotNil(products[1].IntPointer)
+	ts.Equal(2, *products[1].IntPointer)
+	ts.False(products[1].NullFloat.Valid)
+	ts.Equal(20, products[1].GormEmbedded.Int)
+}
+
+func (ts *BulkUpdateIntTestSuite) TestBulkUpdateModelsWithUIntID() {
+	brand1 := ts.createBrand("brand1")
+	brand2 := ts.createBrand("brand2")
+
+	brand1.Name = "updated1"
+	brand2.Name = "updated2"
+
+	updated, err := cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		[]*models.Brand{brand1, brand2},
+		conditions.Brand.Name,
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(2), updated)
+
+	brands, err := cql.Query[models.Brand](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Brand{brand1, brand2}, brands)
+}
+
+func (ts *BulkUpdateIntTestSuite) TestBulkUpdateInBatches() {
+	products := []*models.Product{}
+
+	for i := range 5 {
+		product := ts.createProduct("", i, 0, false, nil)
+		product.Int = i * 10
+		products = append(products, product)
+	}
+
+	updated, err := cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		products,
+		conditions.Product.Int,
+	).ExecInBatches(2)
+	ts.Require().NoError(err)
+	ts.Equal(int64(5), updated)
+
+	productsReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Ascending(conditions.Product.Int).Find()
+	ts.Require().NoError(err)
+	ts.Require().Len(productsReturned, 5)
+
+	for i, product := range productsReturned {
+		ts.Equal(i*10, product.Int)
+	}
+}
+
+func (ts *BulkUpdateIntTestSuite) TestBulkUpdateInBatchesReturnsErrorIfBatchSizeIsNotPositive() {
+	product := ts.createProduct("", 1, 0, false, nil)
+	product.Int = 10
+
+	_, err := cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		[]*models.Product{product},
+		conditions.Product.Int,
+	).ExecInBatches(0)
+	ts.ErrorIs(err, cql.ErrInvalidBatchSize)
+	ts.ErrorContains(err, "method: ExecInBatches")
+
+	products, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(1)),
+	).Find()
+	ts.Require().NoError(err)
+	ts.Len(products, 1)
+}
+
+func (ts *BulkUpdateIntTestSuite) TestBulkUpdateDoesNotUpdateSoftDeletedModels() {
+	product1 := ts.createProduct("", 1, 0, false, nil)
+	product2 := ts.createProduct("", 2, 0, false, nil)
+
+	_, err := cql.Delete[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(2)),
+	).Exec()
+	ts.Require().NoError(err)
+
+	product1.Int = 10
+	product2.Int = 20
+
+	updated, err := cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		[]*models.Product{product1, product2},
+		conditions.Product.Int,
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), updated)
+
+	products, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).WithDeleted().Ascending(conditions.Product.Int).Find()
+	ts.Require().NoError(err)
+	ts.Require().Len(products, 2)
+	ts.Equal(2, products[0].Int)
+	ts.Equal(10, products[1].Int)
+}
+
+func (ts *BulkUpdateIntTestSuite) TestBulkUpdateOfModelNotLoadedReturnsError() {
+	product := ts.createProduct("", 1, 0, false, nil)
+
+	_, err := cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		[]*models.Product{product, {Int: 2}},
+		conditions.Product.Int,
+	).Exec()
+	ts.ErrorIs(err, cql.ErrModelNotLoaded)
+	ts.ErrorContains(err, "method: BulkUpdate")
+}
+
+func (ts *BulkUpdateIntTestSuite) TestBulkUpdateOfNilModelReturnsError() {
+	product := ts.createProduct("", 1, 0, false, nil)
+
+	_, err := cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		[]*models.Product{product, nil},
+		conditions.Product.Int,
+	).Exec()
+	ts.ErrorIs(err, cql.ErrModelNotLoaded)
+	ts.ErrorContains(err, "method: BulkUpdate")
+}
+
+func (ts *BulkUpdateIntTestSuite) TestBulkUpdateToSQL() {
+	product := ts.createProduct("", 1, 0, false, nil)
+	product.Int = 2
+
+	sql, _, err := cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		[]*models.Product{product},
+		conditions.Product.Int,
+	).ToSQL()
+	ts.Require().NoError(err)
+
+	switch getDBDialector() {
+	case cqlSQL.Postgres:
+		ts.Contains(sql, "FROM (VALUES")
+	case cqlSQL.MySQL, cqlSQL.SQLite:
+		ts.Contains(sql, "CASE WHEN")
+	case cqlSQL.SQLServer:
+		ts.Contains(sql, "MERGE INTO")
+	}
+
+	productReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).FindOne()
+	ts.Require().NoError(err)
+	ts.Equal(1, productReturned.Int)
+}
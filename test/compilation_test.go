@@ -99,6 +99,18 @@ func TestQueryCompilationErrors(t *testing.T) {
 			)`,
 			Error: `cannot use cql.String("1") (value of struct type condition.Value[string]) as condition.ValueOfType[float64] value in argument to conditions.Product.Int.Is().In: condition.Value[string] does not implement condition.ValueOfType[float64] (wrong type for method GetValue)`,
 		},
+		{
+			Name: "Compare with wrong type for tuple values",
+			Code: `
+			_ = %s[models.Product](
+				context.Background(),
+				db,
+				cql.Tuple2[models.Product](conditions.Product.Int, conditions.Product.String).In(
+					cql.Values2(cql.String("1"), cql.Int(1)),
+				),
+			)`,
+			Error: `cannot use cql.Values2(cql.String("1"), cql.Int(1)) (value of struct type condition.TupleValues2[string, float64]) as condition.TupleValues2[float64, string] value in argument to cql.Tuple2[models.Product](conditions.Product.Int, conditions.Product.String).In`,
+		},
 		{
 			Name: "Use condition of another model",
 			Code: `
@@ -166,6 +178,21 @@ func TestQueryCompilationErrors(t *testing.T) {
 			)`,
 			Error: `cannot use conditions.Product.ID (variable of struct type condition.Field[models.Product, model.UUID]) as condition.ValueOfType[float64] value in argument to conditions.Product.Int.Is().Eq: condition.Field[models.Product, model.UUID] does not implement condition.ValueOfType[float64] (wrong type for method GetValue)`,
 		},
+		{
+			Name: "Condition with subquery of another type",
+			Code: `
+			_ = %s[models.Product](
+				context.Background(),
+				db,
+				conditions.Product.Int.Is().In(
+					cql.Subquery(
+						cql.Query[models.Product](context.Background(), db),
+						conditions.Product.String,
+					),
+				),
+			)`,
+			Error: `cannot use cql.Subquery(cql.Query[models.Product](context.Background(), db), conditions.Product.String) (value of struct type condition.Subquery[string]) as condition.ValueOfType[float64] value in argument to conditions.Product.Int.Is().In: condition.Subquery[string] does not implement condition.ValueOfType[float64] (wrong type for method GetValue)`,
+		},
 		{
 			Name: "Use operator not present for field type",
 			Code: `
@@ -0,0 +1,204 @@
+package test
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/sql"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type CompositeKeyIntTestSuite struct {
+	testSuite
+}
+
+func NewCompositeKeyIntTestSuite(
+	db *cql.DB,
+) *CompositeKeyIntTestSuite {
+	return &CompositeKeyIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *CompositeKeyIntTestSuite) TestQueryByCompositeKey() {
+	match := ts.createWarehouse(1, 1, "north")
+	ts.createWarehouse(1, 2, "south")
+	ts.createWarehouse(2, 1, "east")
+
+	warehouses, err := cql.Query[models.Warehouse](
+		context.Background(),
+		ts.db,
+		conditions.Warehouse.Zone.Is().Eq(cql.UInt(1)),
+		conditions.Warehouse.Number.Is().Eq(cql.UInt(1)),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Warehouse{match}, warehouses)
+}
+
+func (ts *CompositeKeyIntTestSuite) TestJoinWithCompositeForeignKey() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	warehouse1 := ts.createWarehouse(1, 1, "north")
+	// same zone and number as warehouse1 but inverted, so the join must match all the columns of the key
+	warehouse2 := ts.createWarehouse(1, 2, "south")
+	warehouse3 := ts.createWarehouse(2, 1, "east")
+
+	match := ts.createStock(warehouse1, product, 1)
+	ts.createStock(warehouse2, product, 2)
+	ts.createStock(warehouse3, product, 3)
+
+	stocks, err := cql.Query[models.Stock](
+		context.Background(),
+		ts.db,
+		conditions.Stock.Warehouse(
+			conditions.Warehouse.Name.Is().Eq(cql.String("north")),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Stock{match}, stocks)
+}
+
+func (ts *CompositeKeyIntTestSuite) TestPreloadWithCompositeForeignKey() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	warehouse1 := ts.createWarehouse(1, 1, "north")
+	warehouse2 := ts.createWarehouse(1, 2, "south")
+
+	stock1 := ts.createStock(warehouse1, product, 1)
+	stock2 := ts.createStock(warehouse2, product, 2)
+
+	stocks, err := cql.Query[models.Stock](
+		context.Background(),
+		ts.db,
+		conditions.Stock.Warehouse().Preload(),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Stock{stock1, stock2}, stocks)
+
+	for _, stock := range stocks {
+		warehouse, err := stock.GetWarehouse()
+		ts.Require().NoError(err)
+
+		if stock.Equal(*stock1) {
+			ts.True(warehouse1.Equal(*warehouse))
+			ts.Equal("north", warehouse.Name)
+		} else {
+			ts.True(warehouse2.Equal(*warehouse))
+			ts.Equal("south", warehouse.Name)
+		}
+	}
+}
+
+func (ts *CompositeKeyIntTestSuite) TestCollectionAnyWithCompositeForeignKey() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	warehouse1 := ts.createWarehouse(1, 1, "north")
+	warehouse2 := ts.createWarehouse(1, 2, "south")
+	ts.createWarehouse(2, 1, "east")
+
+	ts.createStock(warehouse1, product, 10)
+	ts.createStock(warehouse2, product, 1)
+
+	warehouses, err := cql.Query[models.Warehouse](
+		context.Background(),
+		ts.db,
+		conditions.Warehouse.Stocks.Any(
+			conditions.Stock.Quantity.Is().Gt(cql.Int(5)),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Warehouse{warehouse1}, warehouses)
+}
+
+func (ts *CompositeKeyIntTestSuite) TestPreloadCollectionWithCompositeForeignKey() {
+	product1 := ts.createProduct("1", 0, 0, false, nil)
+	product2 := ts.createProduct("2", 0, 0, false, nil)
+	warehouse1 := ts.createWarehouse(1, 1, "north")
+	warehouse2 := ts.createWarehouse(1, 2, "south")
+
+	stock1 := ts.createStock(warehouse1, product1, 1)
+	stock2 := ts.createStock(warehouse1, product2, 2)
+
+	warehouses, err := cql.Query[models.Warehouse](
+		context.Background(),
+		ts.db,
+		conditions.Warehouse.Stocks.Preload(),
+	).Descending(conditions.Warehouse.Name).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Warehouse{warehouse1, warehouse2}, warehouses)
+
+	warehouse1Stocks, err := warehouses[1].GetStocks()
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []models.Stock{*stock1, *stock2}, warehouse1Stocks)
+
+	warehouse2Stocks, err := warehouses[0].GetStocks()
+	ts.Require().NoError(err)
+	ts.Empty(warehouse2Stocks)
+}
+
+func (ts *CompositeKeyIntTestSuite) TestDeleteWithJoinAndCompositeKey() {
+	switch getDBDialector() {
+	// delete join only supported for postgres, sqlite, sqlserver
+	case sql.MySQL:
+		_, err := cql.Delete[models.Stock](
+			context.Background(),
+			ts.db,
+			conditions.Stock.Warehouse(
+				conditions.Warehouse.Name.Is().Eq(cql.String("north")),
+			),
+		).Exec()
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+		ts.ErrorContains(err, "method: Delete")
+	case sql.Postgres, sql.SQLite, sql.SQLServer:
+		product := ts.createProduct("", 0, 0, false, nil)
+		warehouse1 := ts.createWarehouse(1, 1, "north")
+		warehouse2 := ts.createWarehouse(1, 2, "south")
+
+		ts.createStock(warehouse1, product, 1)
+		stock2 := ts.createStock(warehouse2, product, 2)
+
+		deleted, err := cql.Delete[models.Stock](
+			context.Background(),
+			ts.db,
+			conditions.Stock.Warehouse(
+				conditions.Warehouse.Name.Is().Eq(cql.String("north")),
+			),
+		).Exec()
+		ts.Require().NoError(err)
+		ts.Equal(int64(1), deleted)
+
+		stocks, err := cql.Query[models.Stock](
+			context.Background(),
+			ts.db,
+		).Find()
+		ts.Require().NoError(err)
+
+		EqualList(&ts.Suite, []*models.Stock{stock2}, stocks)
+	}
+}
+
+func (ts *CompositeKeyIntTestSuite) TestDeleteModelWithCompositeKey() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	warehouse := ts.createWarehouse(1, 1, "north")
+	stock1 := ts.createStock(warehouse, product, 1)
+
+	product2 := ts.createProduct("", 0, 0, false, nil)
+	stock2 := ts.createStock(warehouse, product2, 2)
+
+	deleted, err := cql.DeleteModel(context.Background(), ts.db, stock1).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), deleted)
+
+	stocks, err := cql.Query[models.Stock](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Stock{stock2}, stocks)
+}
@@ -0,0 +1,258 @@
+package test
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type CTEIntTestSuite struct {
+	testSuite
+}
+
+func NewCTEIntTestSuite(
+	db *cql.DB,
+) *CTEIntTestSuite {
+	return &CTEIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *CTEIntTestSuite) TestQueryFromCTE() {
+	match1 := ts.createProduct("match", 1, 0, false, nil)
+	match2 := ts.createProduct("match", 2, 0, false, nil)
+
+	ts.createProduct("match", 3, 0, false, nil)
+	ts.createProduct("not_match", 1, 0, false, nil)
+
+	entities, err := cql.QueryFrom(
+		context.Background(),
+		ts.db,
+		cql.With(
+			"matches",
+			cql.Query[models.Product](
+				context.Background(),
+				ts.db,
+				conditions.Product.String.Is().Eq(cql.String("match")),
+			),
+		),
+		conditions.Product.Int.Is().Lt(cql.Int(3)),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match1, match2}, entities)
+}
+
+func (ts *CTEIntTestSuite) TestQueryFromCTEWithJoin() {
+	product1 := ts.createProduct("", 1, 0, false, nil)
+	product2 := ts.createProduct("", 2, 0, false, nil)
+
+	match := ts.createSale(1, product1, nil)
+	ts.createSale(1, product2, nil)
+	ts.createSale(2, product1, nil)
+
+	entities, err := cql.QueryFrom(
+		context.Background(),
+		ts.db,
+		cql.With(
+			"code_one_sales",
+			cql.Query[models.Sale](
+				context.Background(),
+				ts.db,
+				conditions.Sale.Code.Is().Eq(cql.Int(1)),
+			),
+		),
+		conditions.Sale.Product(
+			conditions.Product.Int.Is().Eq(cql.Int(1)),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Sale{match}, entities)
+}
+
+func (ts *CTEIntTestSuite) TestQueryFromCTECount() {
+	ts.createProduct("match", 1, 0, false, nil)
+	ts.createProduct("match", 2, 0, false, nil)
+	ts.createProduct("not_match", 1, 0, false, nil)
+
+	count, err := cql.QueryFrom(
+		context.Background(),
+		ts.db,
+		cql.With(
+			"matches",
+			cql.Query[models.Product](
+				context.Background(),
+				ts.db,
+				conditions.Product.String.Is().Eq(cql.String("match")),
+			),
+		),
+	).Count()
+	ts.Require().NoError(err)
+	ts.Equal(int64(2), count)
+}
+
+func (ts *CTEIntTestSuite) TestQueryFromCTEWithErrorReturnsError() {
+	_, err := cql.QueryFrom(
+		context.Background(),
+		ts.db,
+		cql.With(
+			"matches",
+			cql.Query[models.Product](
+				context.Background(),
+				ts.db,
+				conditions.Product.Int.Is().Eq(conditions.Sale.Code),
+			),
+		),
+	).Find()
+	ts.ErrorIs(err, cql.ErrFieldModelNotConcerned)
+}
+
+func (ts *CTEIntTestSuite) TestQueryFromCTEWithInvalidNameReturnsError() {
+	_, err := cql.QueryFrom(
+		context.Background(),
+		ts.db,
+		cql.With(
+			"products; DROP TABLE products",
+			cql.Query[models.Product](
+				context.Background(),
+				ts.db,
+			),
+		),
+	).Find()
+	ts.ErrorIs(err, cql.ErrInvalidCTEName)
+	ts.ErrorContains(err, "name: products; DROP TABLE products")
+}
+
+func (ts *CTEIntTestSuite) TestQueryFromRecursiveCTEDescendants() {
+	boss := ts.createEmployee("boss", nil)
+	employee1 := ts.createEmployee("employee1", boss)
+	employee2 := ts.createEmployee("employee2", employee1)
+	employee3 := ts.createEmployee("employee3", employee2)
+
+	ts.createEmployee("other_boss", nil)
+
+	entities, err := cql.QueryFrom(
+		context.Background(),
+		ts.db,
+		cql.WithRecursive(
+			"subordinates",
+			cql.Query[models.Employee](
+				context.Background(),
+				ts.db,
+				conditions.Employee.Name.Is().Eq(cql.String("employee1")),
+			),
+			conditions.Employee.BossID,
+			conditions.Employee.ID,
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Employee{employee1, employee2, employee3}, entities)
+}
+
+func (ts *CTEIntTestSuite) TestQueryFromRecursiveCTEAncestors() {
+	boss := ts.createEmployee("boss", nil)
+	employee1 := ts.createEmployee("employee1", boss)
+	employee2 := ts.createEmployee("employee2", employee1)
+
+	ts.createEmployee("employee3", employee2)
+	ts.createEmployee("other_employee", boss)
+
+	entities, err := cql.QueryFrom(
+		context.Background(),
+		ts.db,
+		cql.WithRecursive(
+			"bosses",
+			cql.Query[models.Employee](
+				context.Background(),
+				ts.db,
+				conditions.Employee.Name.Is().Eq(cql.String("employee2")),
+			),
+			conditions.Employee.ID,
+			conditions.Employee.BossID,
+		),
+		conditions.Employee.Name.Is().NotEq(cql.String("employee2")),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Employee{boss, employee1}, entities)
+}
+
+func (ts *CTEIntTestSuite) TestQueryFromRecursiveCTEWithConditions() {
+	boss := ts.createEmployee("boss", nil)
+	employee1 := ts.createEmployee("employee1", boss)
+	employee2 := ts.createEmployee("employee2", employee1)
+	notMatch := ts.createEmployee("not_match", employee1)
+
+	ts.createEmployee("employee3", notMatch)
+
+	entities, err := cql.QueryFrom(
+		context.Background(),
+		ts.db,
+		cql.WithRecursive(
+			"subordinates",
+			cql.Query[models.Employee](
+				context.Background(),
+				ts.db,
+				conditions.Employee.Name.Is().Eq(cql.String("boss")),
+			),
+			conditions.Employee.BossID,
+			conditions.Employee.ID,
+			conditions.Employee.Name.Is().NotEq(cql.String("not_match")),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Employee{boss, employee1, employee2}, entities)
+}
+
+func (ts *CTEIntTestSuite) TestQueryFromRecursiveCTEWithJoinInOuterQuery() {
+	boss := ts.createEmployee("boss", nil)
+	employee1 := ts.createEmployee("employee1", boss)
+	employee2 := ts.createEmployee("employee2", employee1)
+
+	entities, err := cql.QueryFrom(
+		context.Background(),
+		ts.db,
+		cql.WithRecursive(
+			"subordinates",
+			cql.Query[models.Employee](
+				context.Background(),
+				ts.db,
+				conditions.Employee.Name.Is().Eq(cql.String("boss")),
+			),
+			conditions.Employee.BossID,
+			conditions.Employee.ID,
+		),
+		conditions.Employee.Boss(
+			conditions.Employee.Name.Is().Eq(cql.String("employee1")),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Employee{employee2}, entities)
+}
+
+func (ts *CTEIntTestSuite) TestQueryFromRecursiveCTEWithErrorReturnsError() {
+	_, err := cql.QueryFrom(
+		context.Background(),
+		ts.db,
+		cql.WithRecursive(
+			"subordinates",
+			cql.Query[models.Employee](
+				context.Background(),
+				ts.db,
+				conditions.Employee.Name.Is().Eq(cql.String("boss")),
+			),
+			conditions.Employee.BossID,
+			conditions.Employee.ID,
+			conditions.Employee.Name.Is().Eq(conditions.Sale.Description),
+		),
+	).Find()
+	ts.ErrorIs(err, cql.ErrFieldModelNotConcerned)
+}
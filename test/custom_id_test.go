@@ -0,0 +1,171 @@
+package test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type CustomIDIntTestSuite struct {
+	testSuite
+}
+
+func NewCustomIDIntTestSuite(
+	db *cql.DB,
+) *CustomIDIntTestSuite {
+	return &CustomIDIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *CustomIDIntTestSuite) TestInt64IDIsGeneratedByTheDatabase() {
+	ticket1 := ts.createTicket("first")
+	ticket2 := ts.createTicket("second")
+
+	ts.False(ticket1.ID.IsNil())
+	ts.False(ticket2.ID.IsNil())
+	ts.NotEqual(ticket1.ID, ticket2.ID)
+
+	tickets, err := cql.Query[models.Ticket](
+		context.Background(),
+		ts.db,
+		conditions.Ticket.ID.Is().Eq(cql.ID(ticket2.ID)),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Ticket{ticket2}, tickets)
+}
+
+func (ts *CustomIDIntTestSuite) TestStringIDIsSetByTheUser() {
+	ts.createCurrency("USD", "dollar")
+	match := ts.createCurrency("EUR", "euro")
+
+	currencies, err := cql.Query[models.Currency](
+		context.Background(),
+		ts.db,
+		conditions.Currency.ID.Is().Eq(cql.ID[model.StringID]("EUR")),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Currency{match}, currencies)
+	ts.Equal("euro", currencies[0].Name)
+}
+
+func (ts *CustomIDIntTestSuite) TestCustomIDIsGeneratedWhenNotSet() {
+	currency := ts.createCurrency("USD", "dollar")
+	shipment := ts.createShipment("Paris", nil, currency)
+
+	ts.True(strings.HasPrefix(string(shipment.ID), "TRK-"))
+
+	shipments, err := cql.Query[models.Shipment](
+		context.Background(),
+		ts.db,
+		conditions.Shipment.ID.Is().Eq(cql.ID(shipment.ID)),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Shipment{shipment}, shipments)
+}
+
+func (ts *CustomIDIntTestSuite) TestCustomIDIsNotGeneratedWhenSet() {
+	currency := ts.createCurrency("USD", "dollar")
+
+	shipment := &models.Shipment{
+		Destination: "Paris",
+		CurrencyID:  currency.ID,
+	}
+	shipment.ID = "my-tracking-id"
+
+	err := ts.db.GormDB.Create(shipment).Error
+	ts.Require().NoError(err)
+
+	shipments, err := cql.Query[models.Shipment](
+		context.Background(),
+		ts.db,
+		conditions.Shipment.ID.Is().Eq(cql.ID[models.TrackingID]("my-tracking-id")),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Shipment{shipment}, shipments)
+}
+
+func (ts *CustomIDIntTestSuite) TestJoinWithCustomIDs() {
+	usd := ts.createCurrency("USD", "dollar")
+	eur := ts.createCurrency("EUR", "euro")
+	ticket := ts.createTicket("first")
+
+	match := ts.createShipment("Paris", ticket, eur)
+	ts.createShipment("Paris", nil, eur)
+	ts.createShipment("New York", ticket, usd)
+
+	shipments, err := cql.Query[models.Shipment](
+		context.Background(),
+		ts.db,
+		conditions.Shipment.Ticket(
+			conditions.Ticket.Title.Is().Eq(cql.String("first")),
+		),
+		conditions.Shipment.Currency(
+			conditions.Currency.Name.Is().Eq(cql.String("euro")),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Shipment{match}, shipments)
+}
+
+func (ts *CustomIDIntTestSuite) TestPreloadWithCustomIDs() {
+	usd := ts.createCurrency("USD", "dollar")
+	ticket := ts.createTicket("first")
+
+	withTicket := ts.createShipment("Paris", ticket, usd)
+	withoutTicket := ts.createShipment("New York", nil, usd)
+
+	shipments, err := cql.Query[models.Shipment](
+		context.Background(),
+		ts.db,
+		conditions.Shipment.Ticket().Preload(),
+		conditions.Shipment.Currency().Preload(),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Shipment{withTicket, withoutTicket}, shipments)
+
+	for _, shipment := range shipments {
+		currency, err := shipment.GetCurrency()
+		ts.Require().NoError(err)
+		ts.True(usd.Equal(*currency))
+
+		shipmentTicket, err := shipment.GetTicket()
+		ts.Require().NoError(err)
+
+		if shipment.Equal(*withTicket) {
+			ts.True(ticket.Equal(*shipmentTicket))
+		} else {
+			ts.Nil(shipmentTicket)
+		}
+	}
+}
+
+func (ts *CustomIDIntTestSuite) TestGetRelationWithCustomIDNotLoadedReturnsError() {
+	usd := ts.createCurrency("USD", "dollar")
+	ticket := ts.createTicket("first")
+	ts.createShipment("Paris", ticket, usd)
+
+	shipment, err := cql.Query[models.Shipment](
+		context.Background(),
+		ts.db,
+	).FindOne()
+	ts.Require().NoError(err)
+
+	_, err = shipment.GetTicket()
+	ts.ErrorIs(err, cql.ErrRelationNotLoaded)
+
+	_, err = shipment.GetCurrency()
+	ts.ErrorIs(err, cql.ErrRelationNotLoaded)
+}
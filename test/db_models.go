@@ -30,6 +30,15 @@ var ListOfTables = []any{
 	models.Parent1{},
 	models.Parent2{},
 	models.Child{},
+	models.Account{},
+	models.SaleArchive{},
+	models.Warehouse{},
+	models.Stock{},
+	models.Currency{},
+	models.Ticket{},
+	models.Shipment{},
+	models.Event{},
+	models.Document{},
 }
 
 func CleanDB(db *cql.DB) {
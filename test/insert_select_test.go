@@ -0,0 +1,251 @@
+package test
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type InsertSelectIntTestSuite struct {
+	testSuite
+}
+
+func NewInsertSelectIntTestSuite(
+	db *cql.DB,
+) *InsertSelectIntTestSuite {
+	return &InsertSelectIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *InsertSelectIntTestSuite) TestInsertSelectWhenNothingMatchConditions() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	ts.createSale(0, product, nil)
+
+	inserted, err := cql.InsertSelect[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+			conditions.Sale.Code.Is().Eq(cql.Int(1)),
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(0), inserted)
+
+	archives, err := cql.Query[models.SaleArchive](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	ts.Empty(archives)
+}
+
+func (ts *InsertSelectIntTestSuite) TestInsertSelectInsertsTheModelsThatMatchConditions() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	ts.createSale(0, product, nil)
+	ts.createSale(1, product, nil)
+	ts.createSale(2, product, nil)
+
+	inserted, err := cql.InsertSelect[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+			conditions.Sale.Code.Is().Gt(cql.Int(0)),
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+		conditions.SaleArchive.Description.Set().Eq(conditions.Sale.Description),
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(2), inserted)
+
+	archives, err := cql.Query[models.SaleArchive](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.SaleArchive{{Code: 1}, {Code: 2}}, archives)
+
+	for _, archive := range archives {
+		ts.NotZero(archive.ID)
+		ts.NotZero(archive.CreatedAt)
+		ts.NotZero(archive.UpdatedAt)
+	}
+}
+
+func (ts *InsertSelectIntTestSuite) TestInsertSelectWithStaticValuesAndFunctions() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	ts.createSale(1, product, nil)
+
+	inserted, err := cql.InsertSelect[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code.Plus(cql.Int(10))),
+		conditions.SaleArchive.Description.Set().Eq(cql.String("archived")),
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), inserted)
+
+	archives, err := cql.Query[models.SaleArchive](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.SaleArchive{{Code: 11, Description: "archived"}}, archives)
+}
+
+func (ts *InsertSelectIntTestSuite) TestInsertSelectWithValuesOfJoinedModels() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	seller1 := ts.createSeller("franco", nil)
+	seller2 := ts.createSeller("agustin", nil)
+
+	ts.createSale(1, product, seller1)
+	ts.createSale(2, product, seller2)
+
+	inserted, err := cql.InsertSelect[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+			conditions.Sale.Seller(
+				conditions.Seller.Name.Is().Eq(cql.String("franco")),
+			),
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+		conditions.SaleArchive.Description.Set().Eq(conditions.Seller.Name),
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), inserted)
+
+	archives, err := cql.Query[models.SaleArchive](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.SaleArchive{{Code: 1, Description: "franco"}}, archives)
+}
+
+func (ts *InsertSelectIntTestSuite) TestInsertSelectDoesNotInsertSoftDeletedModels() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	ts.createSale(1, product, nil)
+	ts.createSale(2, product, nil)
+
+	_, err := cql.Delete[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Code.Is().Eq(cql.Int(2)),
+	).Exec()
+	ts.Require().NoError(err)
+
+	inserted, err := cql.InsertSelect[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), inserted)
+
+	archives, err := cql.Query[models.SaleArchive](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.SaleArchive{{Code: 1}}, archives)
+}
+
+func (ts *InsertSelectIntTestSuite) TestInsertSelectToSQLDoesNotInsert() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	ts.createSale(1, product, nil)
+
+	sql, _, err := cql.InsertSelect[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+	).ToSQL()
+	ts.Require().NoError(err)
+	ts.Contains(sql, "INSERT INTO")
+	ts.Contains(sql, "SELECT")
+
+	archives, err := cql.Query[models.SaleArchive](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	ts.Empty(archives)
+}
+
+func (ts *InsertSelectIntTestSuite) TestInsertSelectWithoutSetsReturnsError() {
+	_, err := cql.InsertSelect[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+	).Exec()
+	ts.ErrorContains(err, "at least one set is required; method: InsertSelect")
+}
+
+func (ts *InsertSelectIntTestSuite) TestInsertSelectWithFieldOfNotConcernedModelReturnsError() {
+	_, err := cql.InsertSelect[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+		conditions.SaleArchive.Description.Set().Eq(conditions.Seller.Name),
+	).Exec()
+	ts.ErrorIs(err, cql.ErrFieldModelNotConcerned)
+	ts.ErrorContains(err, "method: InsertSelect")
+}
+
+func (ts *InsertSelectIntTestSuite) TestInsertSelectReturnsErrorIfPrimaryKeyIsNotGenerated() {
+	create(&ts.testSuite, &models.Person{Name: "franco"})
+
+	_, err := cql.InsertSelect[models.Bicycle](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Person](
+			context.Background(),
+			ts.db,
+		),
+		conditions.Bicycle.Name.Set().Eq(conditions.Person.Name),
+		conditions.Bicycle.OwnerName.Set().Eq(conditions.Person.Name),
+	).Exec()
+	ts.ErrorIs(err, cql.ErrPrimaryKeyNotGenerated)
+	ts.ErrorContains(err, "field: ID")
+	ts.ErrorContains(err, "method: InsertSelect")
+
+	bicycles, err := cql.Query[models.Bicycle](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	ts.Empty(bicycles)
+}
@@ -2,6 +2,7 @@ package test
 
 import (
 	"context"
+	"errors"
 
 	"github.com/FrancoLiberali/cql"
 	"github.com/FrancoLiberali/cql/sql"
@@ -1039,3 +1040,30 @@ func (ts *InsertIntTestSuite) TestInsertOneOnConflictSetThatConflictsMultipleWit
 		ts.Len(productsReturned, 1)
 	}
 }
+
+func (ts *InsertIntTestSuite) TestInsertDuplicatedReturnsUniqueViolation() {
+	_, err := cql.Insert(
+		context.Background(),
+		ts.db,
+		&models.Person{Name: "franco"},
+	).Exec()
+	ts.Require().NoError(err)
+
+	_, err = cql.Insert(
+		context.Background(),
+		ts.db,
+		&models.Person{Name: "franco"},
+	).Exec()
+	ts.Require().ErrorIs(err, cql.ErrUniqueViolation)
+
+	var violation *cql.ConstraintViolationError
+
+	ts.Require().True(errors.As(err, &violation))
+
+	switch getDBDialector() {
+	case sql.SQLite:
+		ts.Equal("name", violation.Column)
+	case sql.Postgres, sql.MySQL, sql.SQLServer:
+		ts.NotEmpty(violation.Constraint)
+	}
+}
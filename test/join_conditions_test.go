@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/sql"
 	"github.com/FrancoLiberali/cql/test/conditions"
 	"github.com/FrancoLiberali/cql/test/models"
 	"github.com/FrancoLiberali/cql/unsafe"
@@ -720,3 +721,345 @@ func (ts *JoinConditionsIntTestSuite) TestCollectionAllReturnsIfAllMatch() {
 
 	EqualList(&ts.Suite, []*models.Company{company1}, entities)
 }
+
+func (ts *JoinConditionsIntTestSuite) TestJoinOnWithoutRelation() {
+	product1 := ts.createProduct("", 1, 0.0, false, nil)
+	product2 := ts.createProduct("", 2, 0.0, false, nil)
+
+	match := ts.createSale(1, product2, nil)
+	ts.createSale(3, product1, nil)
+
+	entities, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		cql.JoinOn(
+			conditions.Sale.Code,
+			conditions.Product.Int,
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Sale{match}, entities)
+}
+
+func (ts *JoinConditionsIntTestSuite) TestJoinOnWithConditions() {
+	ts.createProduct("not_match", 1, 0.0, false, nil)
+	product := ts.createProduct("match", 2, 0.0, false, nil)
+
+	match := ts.createSale(2, product, nil)
+	ts.createSale(1, product, nil)
+
+	entities, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		cql.JoinOn(
+			conditions.Sale.Code,
+			conditions.Product.Int,
+			conditions.Product.String.Is().Eq(cql.String("match")),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Sale{match}, entities)
+}
+
+func (ts *JoinConditionsIntTestSuite) TestLeftJoinOnReturnsModelsWithoutMatch() {
+	product := ts.createProduct("match", 1, 0.0, false, nil)
+
+	match1 := ts.createSale(1, product, nil)
+	match2 := ts.createSale(2, product, nil)
+
+	entities, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		cql.LeftJoinOn(
+			conditions.Sale.Code,
+			conditions.Product.Int,
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Sale{match1, match2}, entities)
+
+	entities, err = cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		cql.LeftJoinOn(
+			conditions.Sale.Code,
+			conditions.Product.Int,
+		),
+		conditions.Sale.Description.Is().Eq(conditions.Product.String),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Sale{}, entities)
+}
+
+func (ts *JoinConditionsIntTestSuite) TestJoinOnAllowsDynamicOperatorsAndOrder() {
+	product1 := ts.createProduct("", 1, 0.0, false, nil)
+	product2 := ts.createProduct("", 2, 2.0, false, nil)
+
+	match := ts.createSale(2, product1, nil)
+	ts.createSale(1, product2, nil)
+
+	entities, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		cql.JoinOn(
+			conditions.Sale.Code,
+			conditions.Product.Int,
+		),
+		conditions.Sale.Code.Is().Eq(conditions.Product.Float),
+	).Descending(conditions.Product.Float).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Sale{match}, entities)
+}
+
+func (ts *JoinConditionsIntTestSuite) TestJoinOnSameModelAsRelationNeedsAppearance() {
+	product1 := ts.createProduct("", 1, 0.0, false, nil)
+	product2 := ts.createProduct("not_empty", 2, 0.0, false, nil)
+
+	matchJoinOn := ts.createSale(1, product2, nil)
+	matchRelation := ts.createSale(2, product1, nil)
+
+	_, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Product(),
+		cql.JoinOn(
+			conditions.Sale.Code,
+			conditions.Product.Int,
+		),
+		conditions.Sale.Description.Is().Eq(conditions.Product.String),
+	).Find()
+	ts.ErrorIs(err, cql.ErrAppearanceMustBeSelected)
+
+	entities, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Product(),
+		cql.JoinOn(
+			conditions.Sale.Code,
+			conditions.Product.Int,
+		),
+		conditions.Sale.Description.Is().Eq(conditions.Product.String.Appearance(1)),
+	).Find()
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []*models.Sale{matchJoinOn}, entities)
+
+	entities, err = cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Product(),
+		cql.JoinOn(
+			conditions.Sale.Code,
+			conditions.Product.Int,
+		),
+		conditions.Sale.Description.Is().Eq(conditions.Product.String.Appearance(0)),
+	).Find()
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []*models.Sale{matchRelation}, entities)
+}
+
+func (ts *JoinConditionsIntTestSuite) TestJoinOnWithNestedJoin() {
+	product := ts.createProduct("", 1, 0.0, false, nil)
+	seller1 := ts.createSeller("franco", nil)
+	seller2 := ts.createSeller("agustin", nil)
+
+	ts.createSale(1, product, seller1)
+	ts.createSale(1, product, seller2)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		cql.JoinOn(
+			conditions.Product.Int,
+			conditions.Sale.Code,
+			conditions.Sale.Seller(
+				conditions.Seller.Name.Is().Eq(cql.String("franco")),
+			),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{product}, entities)
+}
+
+func (ts *JoinConditionsIntTestSuite) TestJoinOnPreloadReturnsError() {
+	_, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		cql.JoinOn(
+			conditions.Sale.Code,
+			conditions.Product.Int,
+		).Preload(),
+	).Find()
+	ts.ErrorIs(err, cql.ErrJoinOnPreloadNotAllowed)
+	ts.ErrorContains(err, "model: models.Product")
+}
+
+func (ts *JoinConditionsIntTestSuite) TestConditionThatJoinsWithLeftJoinDoesNotFilter() {
+	product1 := ts.createProduct("", 1, 0.0, false, nil)
+	product2 := ts.createProduct("", 2, 0.0, false, nil)
+
+	match1 := ts.createSale(0, product1, nil)
+	match2 := ts.createSale(0, product2, nil)
+
+	entities, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Product(
+			conditions.Product.Int.Is().Eq(cql.Int(1)),
+		).LeftJoin(),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Sale{match1, match2}, entities)
+}
+
+func (ts *JoinConditionsIntTestSuite) TestConditionThatPreloadsWithInnerJoinFilters() {
+	product := ts.createProduct("", 1, 0.0, false, nil)
+	seller := ts.createSeller("franco", nil)
+
+	match := ts.createSale(0, product, seller)
+	ts.createSale(0, product, nil)
+
+	entities, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Seller().Preload().InnerJoin(),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Sale{match}, entities)
+	ts.Require().NotNil(entities[0].Seller)
+	ts.Equal(seller.ID, entities[0].Seller.ID)
+}
+
+func (ts *JoinConditionsIntTestSuite) TestConditionThatJoinsWithRightJoin() {
+	product := ts.createProduct("", 1, 0.0, false, nil)
+	seller1 := ts.createSeller("franco", nil)
+
+	ts.createSeller("agustin", nil)
+	ts.createSale(0, product, seller1)
+	ts.createSale(0, product, nil)
+
+	count, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Seller().RightJoin(),
+	).Count()
+	ts.Require().NoError(err)
+	ts.Equal(int64(2), count)
+}
+
+func (ts *JoinConditionsIntTestSuite) TestJoinOnWithFullJoin() {
+	product1 := ts.createProduct("", 1, 0.0, false, nil)
+
+	ts.createProduct("", 2, 0.0, false, nil)
+	ts.createSale(1, product1, nil)
+	ts.createSale(3, product1, nil)
+
+	query := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		cql.JoinOn(
+			conditions.Sale.Code,
+			conditions.Product.Int,
+		).FullJoin(),
+	)
+
+	switch getDBDialector() {
+	// full outer join is not supported by mysql
+	case sql.MySQL:
+		_, err := query.Count()
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+		ts.ErrorContains(err, "join: FULL OUTER JOIN")
+	case sql.Postgres, sql.SQLite, sql.SQLServer:
+		// sale 1 with product 1, sale 3 without product and product 2 without sale
+		count, err := query.Count()
+		ts.Require().NoError(err)
+		ts.Equal(int64(3), count)
+	}
+}
+
+func (ts *JoinConditionsIntTestSuite) TestConditionThatJoinsWithRightJoinDoesNotReturnSoftDeletedJoinedModels() {
+	product1 := ts.createProduct("", 1, 0.0, false, nil)
+
+	ts.createProduct("", 2, 0.0, false, nil)
+	ts.createSale(0, product1, nil)
+
+	_, err := cql.Delete[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(2)),
+	).Exec()
+	ts.Require().NoError(err)
+
+	count, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Product().RightJoin(),
+	).Count()
+	ts.Require().NoError(err)
+	// only the sale with product 1
+	ts.Equal(int64(1), count)
+}
+
+func (ts *JoinConditionsIntTestSuite) TestConditionThatJoinsWithRightJoinAppliesConditionsToJoinedModel() {
+	product := ts.createProduct("", 1, 0.0, false, nil)
+	seller1 := ts.createSeller("franco", nil)
+	seller2 := ts.createSeller("agustin", nil)
+
+	ts.createSeller("ana", nil)
+	ts.createSale(0, product, seller1)
+	ts.createSale(0, product, seller2)
+
+	count, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Seller(
+			conditions.Seller.Name.Is().NotEq(cql.String("agustin")),
+		).RightJoin(),
+	).Count()
+	ts.Require().NoError(err)
+	// sale of franco and ana without sale
+	ts.Equal(int64(2), count)
+}
+
+func (ts *JoinConditionsIntTestSuite) TestJoinOnWithFullJoinDoesNotReturnSoftDeletedJoinedModels() {
+	product1 := ts.createProduct("", 1, 0.0, false, nil)
+
+	ts.createProduct("", 2, 0.0, false, nil)
+	ts.createSale(1, product1, nil)
+	ts.createSale(3, product1, nil)
+
+	_, err := cql.Delete[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(2)),
+	).Exec()
+	ts.Require().NoError(err)
+
+	query := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		cql.JoinOn(
+			conditions.Sale.Code,
+			conditions.Product.Int,
+		).FullJoin(),
+	)
+
+	switch getDBDialector() {
+	// full outer join is not supported by mysql
+	case sql.MySQL:
+		_, err := query.Count()
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+	case sql.Postgres, sql.SQLite, sql.SQLServer:
+		// sale 1 with product 1 and sale 3 without product
+		count, err := query.Count()
+		ts.Require().NoError(err)
+		ts.Equal(int64(2), count)
+	}
+}
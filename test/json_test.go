@@ -0,0 +1,212 @@
+package test
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/sql"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type JSONIntTestSuite struct {
+	testSuite
+}
+
+func NewJSONIntTestSuite(
+	db *cql.DB,
+) *JSONIntTestSuite {
+	return &JSONIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *JSONIntTestSuite) TestJSONIsSavedAndLoaded() {
+	document := ts.createDocument(`{"customer": {"name": "franco"}}`, map[string]string{"env": "prod"})
+
+	documents, err := cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Document{document}, documents)
+	ts.JSONEq(string(document.Content), string(documents[0].Content))
+	ts.Nil(documents[0].Metadata)
+	ts.Equal(map[string]string{"env": "prod"}, documents[0].Labels)
+}
+
+func (ts *JSONIntTestSuite) TestJSONPathAsString() {
+	match := ts.createDocument(`{"customer": {"name": "franco"}}`, nil)
+	ts.createDocument(`{"customer": {"name": "other"}}`, nil)
+	ts.createDocument(`{"name": "franco"}`, nil)
+
+	documents, err := cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Content.Path("customer", "name").AsString().Is().Eq(cql.String("franco")),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Document{match}, documents)
+}
+
+func (ts *JSONIntTestSuite) TestJSONPathWithSpecialCharacters() {
+	match := ts.createDocument(`{"first.name": "franco"}`, nil)
+	ts.createDocument(`{"first": {"name": "franco"}}`, nil)
+
+	documents, err := cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Content.Path("first.name").AsString().Is().Eq(cql.String("franco")),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Document{match}, documents)
+}
+
+func (ts *JSONIntTestSuite) TestJSONPathAsNumeric() {
+	match := ts.createDocument(`{"total": 10}`, nil)
+	ts.createDocument(`{"total": 5.5}`, nil)
+	ts.createDocument(`{}`, nil)
+
+	documents, err := cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Content.Path("total").AsNumeric().Is().Gt(cql.Float64(7)),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Document{match}, documents)
+}
+
+func (ts *JSONIntTestSuite) TestJSONPathAsBool() {
+	match := ts.createDocument(`{"paid": true}`, nil)
+	ts.createDocument(`{"paid": false}`, nil)
+	ts.createDocument(`{}`, nil)
+
+	documents, err := cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Content.Path("paid").AsBool().Is().Eq(cql.Bool(true)),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Document{match}, documents)
+}
+
+func (ts *JSONIntTestSuite) TestJSONHasKey() {
+	nested := ts.createDocument(`{"customer": {"name": "franco"}}`, nil)
+	ts.createDocument(`{"customer": {}}`, nil)
+	withNull := ts.createDocument(`{"name": null}`, nil)
+
+	documents, err := cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Content.Is().HasKey("customer", "name"),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Document{nested}, documents)
+
+	documents, err = cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Content.Is().HasKey("name"),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Document{withNull}, documents)
+}
+
+func (ts *JSONIntTestSuite) TestJSONContains() {
+	match := ts.createDocument(`{"tags": ["a", "b"], "customer": {"name": "franco", "age": 30}}`, nil)
+	ts.createDocument(`{"tags": ["b"], "customer": {"name": "franco"}}`, nil)
+
+	documents, err := cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Content.Is().Contains(
+			cql.JSON(model.JSON(`{"tags": ["a"], "customer": {"name": "franco"}}`)),
+		),
+	).Find()
+
+	switch getDBDialector() {
+	case sql.Postgres, sql.MySQL:
+		ts.Require().NoError(err)
+
+		EqualList(&ts.Suite, []*models.Document{match}, documents)
+	case sql.SQLite, sql.SQLServer:
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+		ts.ErrorContains(err, "function: Contains")
+	}
+}
+
+func (ts *JSONIntTestSuite) TestJSONFieldWithSerializer() {
+	match := ts.createDocument(`{}`, map[string]string{"env": "prod"})
+	ts.createDocument(`{}`, map[string]string{"env": "dev"})
+	ts.createDocument(`{}`, nil)
+
+	documents, err := cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Labels.Path("env").AsString().Is().Eq(cql.String("prod")),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Document{match}, documents)
+}
+
+func (ts *JSONIntTestSuite) TestNullableJSON() {
+	withoutMetadata := ts.createDocument(`{}`, nil)
+
+	metadata := model.JSON(`{"source": "api"}`)
+	withMetadata := create(&ts.testSuite, &models.Document{
+		Content:  model.JSON(`{}`),
+		Metadata: &metadata,
+	})
+
+	documents, err := cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Metadata.Is().Null(),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Document{withoutMetadata}, documents)
+
+	documents, err = cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Metadata.Path("source").AsString().Is().Eq(cql.String("api")),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Document{withMetadata}, documents)
+}
+
+func (ts *JSONIntTestSuite) TestUpdateJSON() {
+	document := ts.createDocument(`{"status": "pending"}`, nil)
+
+	updated, err := cql.Update[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Content.Path("status").AsString().Is().Eq(cql.String("pending")),
+	).Set(
+		conditions.Document.Content.Set().Eq(cql.JSON(model.JSON(`{"status": "done"}`))),
+	)
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), updated)
+
+	documents, err := cql.Query[models.Document](
+		context.Background(),
+		ts.db,
+		conditions.Document.Content.Path("status").AsString().Is().Eq(cql.String("done")),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Document{document}, documents)
+}
@@ -0,0 +1,180 @@
+package test
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/sql"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type LockIntTestSuite struct {
+	testSuite
+}
+
+func NewLockIntTestSuite(
+	db *cql.DB,
+) *LockIntTestSuite {
+	return &LockIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *LockIntTestSuite) TestForUpdate() {
+	match := ts.createProduct("match", 1, 0, false, nil)
+	ts.createProduct("not_match", 2, 0, false, nil)
+
+	err := ts.db.Transaction(context.Background(), func(tx *cql.DB) error {
+		entities, err := cql.Query[models.Product](
+			context.Background(),
+			tx,
+			conditions.Product.Int.Is().Eq(cql.Int(1)),
+		).ForUpdate().Find()
+
+		switch getDBDialector() {
+		case sql.SQLite:
+			ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+			ts.ErrorContains(err, "method: ForUpdate")
+		case sql.Postgres, sql.MySQL, sql.SQLServer:
+			ts.Require().NoError(err)
+			EqualList(&ts.Suite, []*models.Product{match}, entities)
+		}
+
+		return nil
+	})
+	ts.Require().NoError(err)
+}
+
+func (ts *LockIntTestSuite) TestForShare() {
+	match := ts.createProduct("match", 1, 0, false, nil)
+	ts.createProduct("not_match", 2, 0, false, nil)
+
+	err := ts.db.Transaction(context.Background(), func(tx *cql.DB) error {
+		entities, err := cql.Query[models.Product](
+			context.Background(),
+			tx,
+			conditions.Product.Int.Is().Eq(cql.Int(1)),
+		).ForShare().Find()
+
+		switch getDBDialector() {
+		case sql.SQLite:
+			ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+			ts.ErrorContains(err, "method: ForShare")
+		case sql.Postgres, sql.MySQL, sql.SQLServer:
+			ts.Require().NoError(err)
+			EqualList(&ts.Suite, []*models.Product{match}, entities)
+		}
+
+		return nil
+	})
+	ts.Require().NoError(err)
+}
+
+func (ts *LockIntTestSuite) TestForUpdateSkipLockedSQL() {
+	statementSQL, _, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(1)),
+	).ForUpdate(cql.SkipLocked()).Limit(1).ToSQL()
+
+	switch getDBDialector() {
+	case sql.SQLite:
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+	case sql.Postgres, sql.MySQL:
+		ts.Require().NoError(err)
+		ts.Contains(statementSQL, "FOR UPDATE SKIP LOCKED")
+	case sql.SQLServer:
+		ts.Require().NoError(err)
+		ts.Contains(statementSQL, "WITH (UPDLOCK, ROWLOCK, READPAST)")
+	}
+}
+
+func (ts *LockIntTestSuite) TestForShareNoWaitSQL() {
+	statementSQL, _, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).ForShare(cql.NoWait()).ToSQL()
+
+	switch getDBDialector() {
+	case sql.SQLite:
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+	case sql.Postgres, sql.MySQL:
+		ts.Require().NoError(err)
+		ts.Contains(statementSQL, "FOR SHARE NOWAIT")
+	case sql.SQLServer:
+		ts.Require().NoError(err)
+		ts.Contains(statementSQL, "WITH (HOLDLOCK, ROWLOCK, NOWAIT)")
+	}
+}
+
+func (ts *LockIntTestSuite) TestForUpdateOfJoinedModel() {
+	product := ts.createProduct("match", 1, 0, false, nil)
+	match := ts.createSale(1, product, nil)
+
+	query := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Product(
+			conditions.Product.String.Is().Eq(cql.String("match")),
+		),
+	).ForUpdate(cql.LockOf[models.Product]())
+
+	statementSQL, _, err := query.ToSQL()
+
+	switch getDBDialector() {
+	case sql.SQLite:
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+	case sql.Postgres, sql.MySQL:
+		ts.Require().NoError(err)
+		ts.Contains(statementSQL, "FOR UPDATE OF Product")
+	case sql.SQLServer:
+		ts.Require().NoError(err)
+		ts.Contains(statementSQL, "products Product WITH (UPDLOCK, ROWLOCK) ON")
+		ts.NotContains(statementSQL, "sales WITH")
+
+		entities, err := query.Find()
+		ts.Require().NoError(err)
+		EqualList(&ts.Suite, []*models.Sale{match}, entities)
+	}
+}
+
+func (ts *LockIntTestSuite) TestForUpdateOfNotConcernedModelReturnsError() {
+	_, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+	).ForUpdate(cql.LockOf[models.Product]()).Find()
+	ts.ErrorIs(err, cql.ErrFieldModelNotConcerned)
+	ts.ErrorContains(err, "not concerned model: models.Product; method: LockOf")
+}
+
+func (ts *LockIntTestSuite) TestSelectForUpdate() {
+	ts.createProduct("1", 1, 0, false, nil)
+	ts.createProduct("2", 2, 0, false, nil)
+
+	err := ts.db.Transaction(context.Background(), func(tx *cql.DB) error {
+		results, err := cql.Select(
+			cql.Query[models.Product](
+				context.Background(),
+				tx,
+				conditions.Product.Int.Is().Eq(cql.Int(2)),
+			).ForUpdate(),
+			cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+				result.Int = int(value)
+			}),
+		)
+
+		switch getDBDialector() {
+		case sql.SQLite:
+			ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+		case sql.Postgres, sql.MySQL, sql.SQLServer:
+			ts.Require().NoError(err)
+			ts.Equal([]Result{{Int: 2}}, results)
+		}
+
+		return nil
+	})
+	ts.Require().NoError(err)
+}
@@ -49,11 +49,24 @@ func TestCQL(t *testing.T) {
 	suite.Run(t, NewOperatorsIntTestSuite(db))
 	suite.Run(t, NewUpdateIntTestSuite(db))
 	suite.Run(t, NewDeleteIntTestSuite(db))
+	suite.Run(t, NewOptimisticLockIntTestSuite(db))
+	suite.Run(t, NewSaveIntTestSuite(db))
+	suite.Run(t, NewBulkUpdateIntTestSuite(db))
 	suite.Run(t, NewSoftDeleteIntTestSuite(db))
 	suite.Run(t, NewGroupByIntTestSuite(db))
 	suite.Run(t, NewSelectIntTestSuite(db))
+	suite.Run(t, NewCTEIntTestSuite(db))
+	suite.Run(t, NewSetOperationIntTestSuite(db))
+	suite.Run(t, NewToSQLIntTestSuite(db))
+	suite.Run(t, NewLockIntTestSuite(db))
 	suite.Run(t, NewFunctionsIntTestSuite(db))
 	suite.Run(t, NewInsertIntTestSuite(db))
+	suite.Run(t, NewInsertSelectIntTestSuite(db))
+	suite.Run(t, NewMergeIntTestSuite(db))
+	suite.Run(t, NewCompositeKeyIntTestSuite(db))
+	suite.Run(t, NewCustomIDIntTestSuite(db))
+	suite.Run(t, NewUUIDv7IntTestSuite(db))
+	suite.Run(t, NewJSONIntTestSuite(db))
 	suite.Run(t, NewTransactionIntTestSuite(db))
 }
 
@@ -0,0 +1,260 @@
+package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/sql"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type MergeIntTestSuite struct {
+	testSuite
+}
+
+func NewMergeIntTestSuite(
+	db *cql.DB,
+) *MergeIntTestSuite {
+	return &MergeIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+// Returns true if merge is not supported by the database, verifying that the error returned is the expected one
+func (ts *MergeIntTestSuite) mergeNotSupported(err error) bool {
+	switch getDBDialector() {
+	case sql.MySQL, sql.SQLite:
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+		ts.ErrorContains(err, "method: Merge")
+
+		return true
+	case sql.Postgres:
+		// postgres < 15 or cockroachdb
+		return errors.Is(err, cql.ErrUnsupportedByDatabase)
+	case sql.SQLServer:
+	}
+
+	return false
+}
+
+func (ts *MergeIntTestSuite) TestMergeInsertsWhenNotMatched() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	ts.createSale(1, product, nil)
+	ts.createSale(2, product, nil)
+
+	merged, err := cql.Merge[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+		conditions.SaleArchive.Description.Set().Eq(cql.String("archived")),
+	).On(
+		conditions.SaleArchive.Code,
+	).WhenNotMatchedInsert().Exec()
+	if ts.mergeNotSupported(err) {
+		return
+	}
+
+	ts.Require().NoError(err)
+	ts.Equal(int64(2), merged)
+
+	archives, err := cql.Query[models.SaleArchive](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.SaleArchive{{Code: 1, Description: "archived"}, {Code: 2, Description: "archived"}}, archives)
+
+	for _, archive := range archives {
+		ts.NotZero(archive.CreatedAt)
+	}
+}
+
+func (ts *MergeIntTestSuite) TestMergeUpdatesWhenMatchedAndInsertsWhenNotMatched() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	ts.createSale(1, product, nil)
+	ts.createSale(2, product, nil)
+
+	archive := ts.createSaleArchive(1, "old")
+	ts.createSaleArchive(3, "old")
+
+	merged, err := cql.Merge[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+		conditions.SaleArchive.Description.Set().Eq(cql.String("new")),
+	).On(
+		conditions.SaleArchive.Code,
+	).WhenMatchedUpdate(
+		conditions.SaleArchive.Description,
+	).WhenNotMatchedInsert().Exec()
+	if ts.mergeNotSupported(err) {
+		return
+	}
+
+	ts.Require().NoError(err)
+	ts.Equal(int64(2), merged)
+
+	archives, err := cql.Query[models.SaleArchive](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(
+		&ts.Suite,
+		[]*models.SaleArchive{{Code: 1, Description: "new"}, {Code: 2, Description: "new"}, {Code: 3, Description: "old"}},
+		archives,
+	)
+
+	for _, archiveReturned := range archives {
+		if archiveReturned.Code == 1 {
+			ts.Equal(archive.ID, archiveReturned.ID)
+			ts.True(archiveReturned.UpdatedAt.After(archive.UpdatedAt))
+		}
+	}
+}
+
+func (ts *MergeIntTestSuite) TestMergeDeletesWhenMatched() {
+	product := ts.createProduct("", 0, 0, false, nil)
+	ts.createSale(1, product, nil)
+
+	ts.createSaleArchive(1, "")
+	ts.createSaleArchive(2, "")
+
+	merged, err := cql.Merge[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+	).On(
+		conditions.SaleArchive.Code,
+	).WhenMatchedDelete().Exec()
+	if ts.mergeNotSupported(err) {
+		return
+	}
+
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), merged)
+
+	archives, err := cql.Query[models.SaleArchive](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.SaleArchive{{Code: 2}}, archives)
+
+	deletedArchives, err := cql.Query[models.SaleArchive](
+		context.Background(),
+		ts.db,
+	).OnlyDeleted().Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.SaleArchive{{Code: 1}}, deletedArchives)
+}
+
+func (ts *MergeIntTestSuite) TestMergeWithoutActionsReturnsError() {
+	_, err := cql.Merge[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+	).On(
+		conditions.SaleArchive.Code,
+	).Exec()
+	ts.ErrorIs(err, cql.ErrMergeWithoutActions)
+	ts.ErrorContains(err, "method: Merge")
+}
+
+func (ts *MergeIntTestSuite) TestMergeOnFieldNotSetReturnsError() {
+	_, err := cql.Merge[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+	).On(
+		conditions.SaleArchive.Description,
+	).WhenNotMatchedInsert().Exec()
+	ts.ErrorIs(err, cql.ErrFieldNotSet)
+	ts.ErrorContains(err, "field: Description; method: Merge")
+}
+
+func (ts *MergeIntTestSuite) TestMergeUpdateOfFieldNotSetReturnsError() {
+	_, err := cql.Merge[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+		conditions.SaleArchive.Code.Set().Eq(conditions.Sale.Code),
+	).On(
+		conditions.SaleArchive.Code,
+	).WhenMatchedUpdate(
+		conditions.SaleArchive.Description,
+	).Exec()
+	ts.ErrorIs(err, cql.ErrFieldNotSet)
+	ts.ErrorContains(err, "field: Description; method: Merge")
+}
+
+func (ts *MergeIntTestSuite) TestMergeWithoutSetsReturnsError() {
+	_, err := cql.Merge[models.SaleArchive](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		),
+	).On(
+		conditions.SaleArchive.Code,
+	).WhenNotMatchedInsert().Exec()
+	ts.ErrorContains(err, "at least one set is required; method: Merge")
+}
+
+func (ts *MergeIntTestSuite) TestMergeInsertReturnsErrorIfPrimaryKeyIsNotGenerated() {
+	create(&ts.testSuite, &models.Person{Name: "franco"})
+
+	_, err := cql.Merge[models.Bicycle](
+		context.Background(),
+		ts.db,
+		cql.Query[models.Person](
+			context.Background(),
+			ts.db,
+		),
+		conditions.Bicycle.Name.Set().Eq(conditions.Person.Name),
+		conditions.Bicycle.OwnerName.Set().Eq(conditions.Person.Name),
+	).On(
+		conditions.Bicycle.Name,
+	).WhenNotMatchedInsert().Exec()
+	ts.ErrorIs(err, cql.ErrPrimaryKeyNotGenerated)
+	ts.ErrorContains(err, "field: ID; method: WhenNotMatchedInsert; method: Merge")
+
+	bicycles, err := cql.Query[models.Bicycle](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	ts.Empty(bicycles)
+}
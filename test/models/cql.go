@@ -63,3 +63,18 @@ func (m SellerNoTimestamps) GetCompanyNoTimestamps() (*CompanyNoTimestamps, erro
 func (m SellerNoTimestamps) GetUniversity() (*University, error) {
 	return preload.VerifyPointerLoaded[University](m.UniversityID, m.University)
 }
+func (m Shipment) GetTicket() (*Ticket, error) {
+	return preload.VerifyPointerLoaded[Ticket](m.TicketID, m.Ticket)
+}
+func (m Shipment) GetCurrency() (*Currency, error) {
+	return preload.VerifyPointerWithIDLoaded[Currency](m.CurrencyID, m.Currency)
+}
+func (m Stock) GetWarehouse() (*Warehouse, error) {
+	return preload.VerifyStructLoaded[Warehouse](&m.Warehouse)
+}
+func (m Stock) GetProduct() (*Product, error) {
+	return preload.VerifyStructLoaded[Product](&m.Product)
+}
+func (m Warehouse) GetStocks() ([]Stock, error) {
+	return preload.VerifyCollectionLoaded[Stock](m.Stocks)
+}
@@ -342,3 +342,143 @@ type Child struct {
 func (m Child) Equal(other Child) bool {
 	return m.ID == other.ID
 }
+
+type Account struct {
+	model.UIntModel
+	model.Versioned
+
+	Name    string
+	Balance int
+}
+
+func (m Account) Equal(other Account) bool {
+	return m.ID == other.ID
+}
+
+type SaleArchive struct {
+	model.UIntModelWithTimestamps
+
+	Code        int
+	Description string
+}
+
+func (m SaleArchive) Equal(other SaleArchive) bool {
+	return m.Code == other.Code && m.Description == other.Description
+}
+
+// Warehouse has a composite primary key
+type Warehouse struct {
+	model.CompositeKeyModelWithTimestamps
+
+	Zone   uint `gorm:"primaryKey;autoIncrement:false"`
+	Number uint `gorm:"primaryKey;autoIncrement:false"`
+	Name   string
+	Stocks *[]Stock // Warehouse HasMany Stocks (Warehouse 1 -> 0..* Stock)
+}
+
+func (m Warehouse) IsLoaded() bool {
+	return m.Zone != 0 || m.Number != 0
+}
+
+func (m Warehouse) Equal(other Warehouse) bool {
+	return m.Zone == other.Zone && m.Number == other.Number
+}
+
+// Stock has a composite primary key that includes the composite foreign key to its Warehouse
+type Stock struct {
+	model.CompositeKeyModel
+
+	Warehouse       Warehouse
+	WarehouseZone   uint `gorm:"primaryKey;autoIncrement:false"` // Warehouse HasMany Stocks (Warehouse 1 -> 0..* Stock)
+	WarehouseNumber uint `gorm:"primaryKey;autoIncrement:false"`
+	Product         Product
+	ProductID       model.UUID `gorm:"primaryKey"`
+	Quantity        int
+}
+
+func (m Stock) IsLoaded() bool {
+	return !m.ProductID.IsNil()
+}
+
+func (m Stock) Equal(other Stock) bool {
+	return m.WarehouseZone == other.WarehouseZone &&
+		m.WarehouseNumber == other.WarehouseNumber &&
+		m.ProductID == other.ProductID
+}
+
+// Currency is identified by its code, set by the user
+type Currency struct {
+	model.StringModel
+
+	Name string
+}
+
+func (m Currency) Equal(other Currency) bool {
+	return m.ID == other.ID
+}
+
+// Ticket is identified by a bigint identity column
+type Ticket struct {
+	model.Int64ModelWithTimestamps
+
+	Title string
+}
+
+func (m Ticket) Equal(other Ticket) bool {
+	return m.ID == other.ID
+}
+
+// TrackingID is a custom id generated when a Shipment is created
+type TrackingID string
+
+const trackingIDPrefix = "TRK-"
+
+func (id TrackingID) IsNil() bool {
+	return id == ""
+}
+
+func (id TrackingID) Generate() TrackingID {
+	return TrackingID(trackingIDPrefix + model.NewUUID().String())
+}
+
+func (id TrackingID) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return model.StringID(id).GormDBDataType(db, field)
+}
+
+type Shipment struct {
+	model.IDModelWithTimestamps[TrackingID]
+
+	Destination string
+	Ticket      *Ticket
+	TicketID    *model.Int64ID
+	Currency    *Currency
+	CurrencyID  model.StringID
+}
+
+func (m Shipment) Equal(other Shipment) bool {
+	return m.ID == other.ID
+}
+
+// Event is identified by a time-ordered uuid
+type Event struct {
+	model.UUIDv7ModelWithTimestamps
+
+	Name string
+}
+
+func (m Event) Equal(other Event) bool {
+	return m.ID == other.ID
+}
+
+// Document stores json documents
+type Document struct {
+	model.UUIDModel
+
+	Content  model.JSON
+	Metadata *model.JSON
+	Labels   map[string]string `gorm:"serializer:json"`
+}
+
+func (m Document) Equal(other Document) bool {
+	return m.ID == other.ID
+}
@@ -441,6 +441,185 @@ func (ts *OperatorsIntTestSuite) TestArrayNotIn() {
 	EqualList(&ts.Suite, []*models.Product{match1, match2}, entities)
 }
 
+func (ts *OperatorsIntTestSuite) TestTupleIn() {
+	match1 := ts.createProduct("s1", 1, 0, false, nil)
+	match2 := ts.createProduct("s2", 2, 0, false, nil)
+
+	ts.createProduct("s1", 2, 0, false, nil)
+	ts.createProduct("s2", 1, 0, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		cql.Tuple2[models.Product](conditions.Product.String, conditions.Product.Int).In(
+			cql.Values2(cql.String("s1"), cql.Int(1)),
+			cql.Values2(cql.String("s2"), cql.Int(2)),
+			cql.Values2(cql.String("s3"), cql.Int(3)),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match1, match2}, entities)
+}
+
+func (ts *OperatorsIntTestSuite) TestTupleNotIn() {
+	match1 := ts.createProduct("s1", 2, 0, false, nil)
+	match2 := ts.createProduct("s2", 1, 0, false, nil)
+
+	ts.createProduct("s1", 1, 0, false, nil)
+	ts.createProduct("s2", 2, 0, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		cql.Tuple2[models.Product](conditions.Product.String, conditions.Product.Int).NotIn(
+			cql.Values2(cql.String("s1"), cql.Int(1)),
+			cql.Values2(cql.String("s2"), cql.Int(2)),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match1, match2}, entities)
+}
+
+func (ts *OperatorsIntTestSuite) TestTuple3In() {
+	match := ts.createProduct("s1", 1, 1.5, false, nil)
+
+	ts.createProduct("s1", 1, 2.5, false, nil)
+	ts.createProduct("s1", 2, 1.5, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		cql.Tuple3[models.Product](conditions.Product.String, conditions.Product.Int, conditions.Product.Float).In(
+			cql.Values3(cql.String("s1"), cql.Int(1), cql.Float64(1.5)),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match}, entities)
+}
+
+func (ts *OperatorsIntTestSuite) TestTupleInWithFieldsOfJoinedModels() {
+	product1 := ts.createProduct("", 1, 0, false, nil)
+	product2 := ts.createProduct("", 2, 0, false, nil)
+
+	match := ts.createSale(1, product1, nil)
+	ts.createSale(1, product2, nil)
+	ts.createSale(2, product1, nil)
+
+	entities, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Product(),
+		cql.Tuple2[models.Sale](conditions.Sale.Code, conditions.Product.Int).In(
+			cql.Values2(cql.Int(1), cql.Int(1)),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Sale{match}, entities)
+}
+
+func (ts *OperatorsIntTestSuite) TestTupleInWithFieldsAsValues() {
+	product1 := ts.createProduct("", 1, 1, false, nil)
+	ts.createProduct("", 1, 2, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		cql.Tuple2[models.Product](conditions.Product.Int, conditions.Product.String).In(
+			cql.Values2(conditions.Product.Float, cql.String("")),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{product1}, entities)
+}
+
+func (ts *OperatorsIntTestSuite) TestArrayInSubquery() {
+	product1 := ts.createProduct("", 1, 0, false, nil)
+	product2 := ts.createProduct("", 2, 0, false, nil)
+
+	match1 := ts.createSeller("match1", nil)
+	match2 := ts.createSeller("match2", nil)
+	notMatch := ts.createSeller("not_match", nil)
+
+	ts.createSale(1, product1, match1)
+	ts.createSale(1, product2, match2)
+	ts.createSale(2, product1, notMatch)
+
+	entities, err := cql.Query[models.Seller](
+		context.Background(),
+		ts.db,
+		conditions.Seller.ID.Is().In(
+			cql.Subquery(
+				cql.Query[models.Sale](
+					context.Background(),
+					ts.db,
+					conditions.Sale.Code.Is().Eq(cql.Int(1)),
+				),
+				conditions.Sale.SellerID,
+			),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Seller{match1, match2}, entities)
+}
+
+func (ts *OperatorsIntTestSuite) TestArrayNotInSubquery() {
+	match1 := ts.createProduct("s1", 1, 0, false, nil)
+	match2 := ts.createProduct("s2", 2, 0, false, nil)
+
+	ts.createProduct("ns1", 3, 0, false, nil)
+	ts.createProduct("ns2", 4, 0, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().NotIn(
+			cql.Subquery(
+				cql.Query[models.Product](
+					context.Background(),
+					ts.db,
+					conditions.Product.String.Is().Like("ns%"),
+				),
+				conditions.Product.Int,
+			),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match1, match2}, entities)
+}
+
+func (ts *OperatorsIntTestSuite) TestArrayInWithSubqueryAndValues() {
+	match1 := ts.createProduct("s1", 1, 0, false, nil)
+	match2 := ts.createProduct("s2", 2, 0, false, nil)
+
+	ts.createProduct("ns1", 3, 0, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().In(
+			cql.Int(1),
+			cql.Subquery(
+				cql.Query[models.Product](
+					context.Background(),
+					ts.db,
+					conditions.Product.String.Is().Eq(cql.String("s2")),
+				),
+				conditions.Product.Int,
+			),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match1, match2}, entities)
+}
+
 func (ts *OperatorsIntTestSuite) TestLike() {
 	match1 := ts.createProduct("basd", 0, 0, false, nil)
 	match2 := ts.createProduct("cape", 0, 0, false, nil)
@@ -917,3 +1096,113 @@ func (ts *OperatorsIntTestSuite) TestUnsafeOperatorCanCompareFieldsThatMapToTheS
 
 	EqualList(&ts.Suite, []*models.Product{match}, entities)
 }
+
+func (ts *OperatorsIntTestSuite) TestSubqueryAsScalarValue() {
+	match1 := ts.createProduct("", 0, 3, false, nil)
+	match2 := ts.createProduct("", 0, 4, false, nil)
+
+	ts.createProduct("", 0, 1, false, nil)
+	ts.createProduct("", 0, 2, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Float.Is().Gt(
+			cql.Subquery(
+				cql.Query[models.Product](
+					context.Background(),
+					ts.db,
+				),
+				conditions.Product.Float.Aggregate().Average(),
+			),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match1, match2}, entities)
+}
+
+func (ts *OperatorsIntTestSuite) TestSubqueryAsScalarValueOfAnotherModel() {
+	product1 := ts.createProduct("", 1, 0, false, nil)
+	product2 := ts.createProduct("", 2, 0, false, nil)
+
+	ts.createSale(1, product1, nil)
+	ts.createSale(2, product2, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(
+			cql.Subquery(
+				cql.Query[models.Sale](
+					context.Background(),
+					ts.db,
+				),
+				conditions.Sale.Code.Aggregate().Max(),
+			),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{product2}, entities)
+}
+
+func (ts *OperatorsIntTestSuite) TestSubqueryDoesNotReturnDeletedModels() {
+	match := ts.createProduct("", 1, 0, false, nil)
+	deleted := ts.createProduct("", 2, 0, false, nil)
+
+	ts.Require().NoError(ts.db.GormDB.Delete(deleted).Error)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(
+			cql.Subquery(
+				cql.Query[models.Product](
+					context.Background(),
+					ts.db,
+				),
+				conditions.Product.Int.Aggregate().Max(),
+			),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match}, entities)
+}
+
+func (ts *OperatorsIntTestSuite) TestSubqueryWithFieldNotConcernedReturnsError() {
+	_, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().In(
+			cql.Subquery(
+				cql.Query[models.Product](
+					context.Background(),
+					ts.db,
+				),
+				conditions.Sale.Code,
+			),
+		),
+	).Find()
+	ts.ErrorIs(err, cql.ErrFieldModelNotConcerned)
+	ts.ErrorContains(err, "not concerned model: models.Sale; operator: ArrayIn; model: models.Product, field: Int")
+}
+
+func (ts *OperatorsIntTestSuite) TestSubqueryWithErrorReturnsError() {
+	_, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(
+			cql.Subquery(
+				cql.Query[models.Product](
+					context.Background(),
+					ts.db,
+					conditions.Product.Int.Is().Eq(conditions.Sale.Code),
+				),
+				conditions.Product.Int.Aggregate().Max(),
+			),
+		),
+	).Find()
+	ts.ErrorIs(err, cql.ErrFieldModelNotConcerned)
+}
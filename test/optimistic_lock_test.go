@@ -0,0 +1,204 @@
+package test
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type OptimisticLockIntTestSuite struct {
+	testSuite
+}
+
+func NewOptimisticLockIntTestSuite(
+	db *cql.DB,
+) *OptimisticLockIntTestSuite {
+	return &OptimisticLockIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *OptimisticLockIntTestSuite) findAccount(account *models.Account) *models.Account {
+	accountReturned, err := cql.Query[models.Account](
+		context.Background(),
+		ts.db,
+		conditions.Account.Name.Is().Eq(cql.String(account.Name)),
+	).FindOne()
+	ts.Require().NoError(err)
+
+	return accountReturned
+}
+
+func (ts *OptimisticLockIntTestSuite) TestUpdateIncrementsVersion() {
+	account := ts.createAccount("account", 0)
+	ts.Equal(uint(0), account.Version)
+
+	updated, err := cql.Update[models.Account](
+		context.Background(),
+		ts.db,
+		conditions.Account.Name.Is().Eq(cql.String("account")),
+	).Set(
+		conditions.Account.Balance.Set().Eq(cql.Int(1)),
+	)
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), updated)
+
+	accountReturned := ts.findAccount(account)
+	ts.Equal(1, accountReturned.Balance)
+	ts.Equal(uint(1), accountReturned.Version)
+}
+
+func (ts *OptimisticLockIntTestSuite) TestUpdateModel() {
+	account := ts.createAccount("account", 0)
+	ts.createAccount("other", 0)
+
+	loaded := ts.findAccount(account)
+
+	updated, err := cql.UpdateModel(context.Background(), ts.db, loaded).Set(
+		conditions.Account.Balance.Set().Eq(cql.Int(1)),
+	)
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), updated)
+	ts.Equal(uint(1), loaded.Version)
+
+	accountReturned := ts.findAccount(account)
+	ts.Equal(1, accountReturned.Balance)
+	ts.Equal(uint(1), accountReturned.Version)
+
+	// the version of the loaded model is updated so it can be updated again
+	updated, err = cql.UpdateModel(context.Background(), ts.db, loaded).Set(
+		conditions.Account.Balance.Set().Eq(cql.Int(2)),
+	)
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), updated)
+	ts.Equal(uint(2), loaded.Version)
+}
+
+func (ts *OptimisticLockIntTestSuite) TestUpdateModelReturnsErrorIfModelIsStale() {
+	account := ts.createAccount("account", 0)
+
+	loaded := ts.findAccount(account)
+	stale := ts.findAccount(account)
+
+	_, err := cql.UpdateModel(context.Background(), ts.db, loaded).Set(
+		conditions.Account.Balance.Set().Eq(cql.Int(1)),
+	)
+	ts.Require().NoError(err)
+
+	_, err = cql.UpdateModel(context.Background(), ts.db, stale).Set(
+		conditions.Account.Balance.Set().Eq(cql.Int(2)),
+	)
+	ts.ErrorIs(err, cql.ErrStaleObject)
+	ts.ErrorContains(err, "method: Set")
+	ts.Equal(uint(0), stale.Version)
+
+	accountReturned := ts.findAccount(account)
+	ts.Equal(1, accountReturned.Balance)
+	ts.Equal(uint(1), accountReturned.Version)
+}
+
+func (ts *OptimisticLockIntTestSuite) TestUpdateModelReturnsErrorIfModelIsNotLoaded() {
+	_, err := cql.UpdateModel(context.Background(), ts.db, &models.Account{}).Set(
+		conditions.Account.Balance.Set().Eq(cql.Int(1)),
+	)
+	ts.ErrorIs(err, cql.ErrModelNotLoaded)
+	ts.ErrorContains(err, "method: UpdateModel")
+}
+
+func (ts *OptimisticLockIntTestSuite) TestDeleteModel() {
+	account := ts.createAccount("account", 0)
+	other := ts.createAccount("other", 0)
+
+	deleted, err := cql.DeleteModel(context.Background(), ts.db, ts.findAccount(account)).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), deleted)
+
+	accountsReturned, err := cql.Query[models.Account](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []*models.Account{other}, accountsReturned)
+}
+
+func (ts *OptimisticLockIntTestSuite) TestDeleteModelReturnsErrorIfModelIsStale() {
+	account := ts.createAccount("account", 0)
+
+	stale := ts.findAccount(account)
+
+	_, err := cql.UpdateModel(context.Background(), ts.db, ts.findAccount(account)).Set(
+		conditions.Account.Balance.Set().Eq(cql.Int(1)),
+	)
+	ts.Require().NoError(err)
+
+	_, err = cql.DeleteModel(context.Background(), ts.db, stale).Exec()
+	ts.ErrorIs(err, cql.ErrStaleObject)
+	ts.ErrorContains(err, "method: Exec")
+
+	accountReturned := ts.findAccount(account)
+	ts.Equal(uint(1), accountReturned.Version)
+}
+
+func (ts *OptimisticLockIntTestSuite) TestBulkUpdateIncrementsVersion() {
+	account1 := ts.createAccount("account1", 0)
+	account2 := ts.createAccount("account2", 0)
+
+	account1.Balance = 1
+	account2.Balance = 2
+
+	updated, err := cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		[]*models.Account{account1, account2},
+		conditions.Account.Balance,
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(2), updated)
+	ts.Equal(uint(1), account1.Version)
+	ts.Equal(uint(1), account2.Version)
+
+	account1Returned := ts.findAccount(account1)
+	ts.Equal(1, account1Returned.Balance)
+	ts.Equal(uint(1), account1Returned.Version)
+
+	account2Returned := ts.findAccount(account2)
+	ts.Equal(2, account2Returned.Balance)
+	ts.Equal(uint(1), account2Returned.Version)
+}
+
+func (ts *OptimisticLockIntTestSuite) TestBulkUpdateReturnsErrorIfAModelIsStale() {
+	account1 := ts.createAccount("account1", 0)
+	account2 := ts.createAccount("account2", 0)
+
+	// account2 is modified by other transaction
+	_, err := cql.UpdateModel(context.Background(), ts.db, ts.findAccount(account2)).Set(
+		conditions.Account.Balance.Set().Eq(cql.Int(5)),
+	)
+	ts.Require().NoError(err)
+
+	account1.Balance = 1
+	account2.Balance = 2
+
+	_, err = cql.BulkUpdate(
+		context.Background(),
+		ts.db,
+		[]*models.Account{account1, account2},
+		conditions.Account.Balance,
+	).Exec()
+	ts.ErrorIs(err, cql.ErrStaleObject)
+	ts.ErrorContains(err, "method: BulkUpdate")
+	ts.Equal(uint(0), account1.Version)
+
+	// none of the models is updated
+	account1Returned := ts.findAccount(account1)
+	ts.Equal(0, account1Returned.Balance)
+	ts.Equal(uint(0), account1Returned.Version)
+
+	account2Returned := ts.findAccount(account2)
+	ts.Equal(5, account2Returned.Balance)
+	ts.Equal(uint(1), account2Returned.Version)
+}
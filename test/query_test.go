@@ -2,12 +2,14 @@ package test
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/go-cmp/cmp"
 	"gorm.io/gorm"
 	"gotest.tools/assert"
 
 	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/condition"
 	"github.com/FrancoLiberali/cql/sql"
 	"github.com/FrancoLiberali/cql/test/conditions"
 	"github.com/FrancoLiberali/cql/test/models"
@@ -405,3 +407,424 @@ func (ts *QueryIntTestSuite) TestOffsetAndLimitWorkTogether() {
 
 	EqualList(&ts.Suite, []*models.Product{product2}, products)
 }
+
+// ------------------------- Iter --------------------------------
+
+func (ts *QueryIntTestSuite) TestIterReturnsAllModelsThatMatchConditions() {
+	product1 := ts.createProduct("", 1, 0, false, nil)
+	product2 := ts.createProduct("", 1, 0, false, nil)
+	ts.createProduct("", 2, 0, false, nil)
+
+	products := []*models.Product{}
+
+	for product, err := range cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(1)),
+	).Iter() {
+		ts.Require().NoError(err)
+
+		products = append(products, product)
+	}
+
+	EqualList(&ts.Suite, []*models.Product{product1, product2}, products)
+}
+
+func (ts *QueryIntTestSuite) TestIterCanBeStoppedBeforeTheEnd() {
+	ts.createProduct("", 1, 1, false, nil)
+	ts.createProduct("", 1, 2, false, nil)
+	ts.createProduct("", 1, 3, false, nil)
+
+	iterations := 0
+
+	for _, err := range cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Iter() {
+		ts.Require().NoError(err)
+
+		iterations++
+		if iterations == 2 {
+			break
+		}
+	}
+
+	ts.Equal(2, iterations)
+}
+
+func (ts *QueryIntTestSuite) TestIterAppliesPreloadsOfJoinConditions() {
+	product := ts.createProduct("", 1, 0, false, nil)
+	seller := ts.createSeller("franco", nil)
+	sale := ts.createSale(0, product, seller)
+
+	sales := []*models.Sale{}
+
+	for saleReturned, err := range cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Seller().Preload(),
+	).Iter() {
+		ts.Require().NoError(err)
+
+		sales = append(sales, saleReturned)
+	}
+
+	EqualList(&ts.Suite, []*models.Sale{sale}, sales)
+
+	saleSeller, err := sales[0].GetSeller()
+	ts.Require().NoError(err)
+	assert.DeepEqual(ts.T(), seller, saleSeller)
+}
+
+func (ts *QueryIntTestSuite) TestIterReturnsErrorIfCollectionIsPreloaded() {
+	company := ts.createCompany("ditrit")
+	ts.createSeller("franco", company)
+
+	iterations := 0
+
+	for model, err := range cql.Query[models.Company](
+		context.Background(),
+		ts.db,
+		conditions.Company.Sellers.Preload(),
+	).Iter() {
+		iterations++
+
+		ts.Nil(model)
+		ts.ErrorIs(err, cql.ErrCollectionPreloadsNotAllowed)
+	}
+
+	ts.Equal(1, iterations)
+}
+
+func (ts *QueryIntTestSuite) TestIterReturnsErrorIfQueryHasError() {
+	iterations := 0
+
+	for _, err := range cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Descending(conditions.Sale.Code).Iter() {
+		iterations++
+
+		ts.ErrorIs(err, cql.ErrFieldModelNotConcerned)
+	}
+
+	ts.Equal(1, iterations)
+}
+
+// ------------------------- Each --------------------------------
+
+func (ts *QueryIntTestSuite) TestEachCallsFunctionForEachModel() {
+	product1 := ts.createProduct("", 1, 0, false, nil)
+	product2 := ts.createProduct("", 1, 0, false, nil)
+
+	products := []*models.Product{}
+
+	err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Each(func(product *models.Product) error {
+		products = append(products, product)
+
+		return nil
+	})
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{product1, product2}, products)
+}
+
+func (ts *QueryIntTestSuite) TestEachStopsAtFirstError() {
+	ts.createProduct("", 1, 0, false, nil)
+	ts.createProduct("", 1, 0, false, nil)
+
+	errForEach := errors.New("for each error")
+	iterations := 0
+
+	err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Each(func(_ *models.Product) error {
+		iterations++
+
+		return errForEach
+	})
+	ts.Require().ErrorIs(err, errForEach)
+	ts.Equal(1, iterations)
+}
+
+// ------------------------- FindInBatches --------------------------------
+
+func (ts *QueryIntTestSuite) TestFindInBatchesReturnsAllModelsInBatches() {
+	product1 := ts.createProduct("", 1, 0, false, nil)
+	product2 := ts.createProduct("", 1, 0, false, nil)
+	product3 := ts.createProduct("", 1, 0, false, nil)
+	product4 := ts.createProduct("", 1, 0, false, nil)
+	product5 := ts.createProduct("", 1, 0, false, nil)
+	ts.createProduct("", 2, 0, false, nil)
+
+	products := []*models.Product{}
+	batchSizes := []int{}
+	batchNumbers := []int{}
+
+	err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(1)),
+	).FindInBatches(2, func(batch []*models.Product, batchNumber int) error {
+		products = append(products, batch...)
+		batchSizes = append(batchSizes, len(batch))
+		batchNumbers = append(batchNumbers, batchNumber)
+
+		return nil
+	})
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{product1, product2, product3, product4, product5}, products)
+	ts.Equal([]int{2, 2, 1}, batchSizes)
+	ts.Equal([]int{1, 2, 3}, batchNumbers)
+}
+
+func (ts *QueryIntTestSuite) TestFindInBatchesUsesOrder() {
+	product1 := ts.createProduct("", 1, 0, false, nil)
+	product2 := ts.createProduct("", 2, 0, false, nil)
+	product3 := ts.createProduct("", 2, 0, false, nil)
+	product4 := ts.createProduct("", 3, 0, false, nil)
+
+	products := []*models.Product{}
+
+	err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Descending(conditions.Product.Int).FindInBatches(3, func(batch []*models.Product, _ int) error {
+		products = append(products, batch...)
+
+		return nil
+	})
+	ts.Require().NoError(err)
+
+	ts.Require().Len(products, 4)
+	assert.DeepEqual(ts.T(), product4, products[0])
+	EqualList(&ts.Suite, []*models.Product{product2, product3}, products[1:3])
+	assert.DeepEqual(ts.T(), product1, products[3])
+}
+
+func (ts *QueryIntTestSuite) TestFindInBatchesWithoutModelsDoesNotCallFunction() {
+	called := false
+
+	err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).FindInBatches(2, func(_ []*models.Product, _ int) error {
+		called = true
+
+		return nil
+	})
+	ts.Require().NoError(err)
+	ts.False(called)
+}
+
+func (ts *QueryIntTestSuite) TestFindInBatchesStopsAtFirstError() {
+	ts.createProduct("", 1, 0, false, nil)
+	ts.createProduct("", 1, 0, false, nil)
+	ts.createProduct("", 1, 0, false, nil)
+
+	errBatch := errors.New("batch error")
+	calls := 0
+
+	err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).FindInBatches(1, func(_ []*models.Product, _ int) error {
+		calls++
+
+		return errBatch
+	})
+	ts.Require().ErrorIs(err, errBatch)
+	ts.Equal(1, calls)
+}
+
+func (ts *QueryIntTestSuite) TestFindInBatchesReturnsErrorIfOrderIsByJoinedModel() {
+	err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Product(),
+	).Ascending(conditions.Product.Int).FindInBatches(1, func(_ []*models.Sale, _ int) error {
+		return nil
+	})
+	ts.ErrorIs(err, cql.ErrKeysetOrderNotAllowed)
+	ts.ErrorContains(err, "model: models.Product, field: Int; method: FindInBatches")
+}
+
+func (ts *QueryIntTestSuite) TestFindInBatchesDoesNotModifyTheQuery() {
+	ts.createProduct("", 1, 0, false, nil)
+
+	query := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Descending(conditions.Product.Int)
+
+	sqlBefore, _, err := query.ToSQL()
+	ts.Require().NoError(err)
+
+	err = query.FindInBatches(1, func(_ []*models.Product, _ int) error {
+		return nil
+	})
+	ts.Require().NoError(err)
+
+	sqlAfter, _, err := query.ToSQL()
+	ts.Require().NoError(err)
+	ts.Equal(sqlBefore, sqlAfter)
+}
+
+func (ts *QueryIntTestSuite) TestFindInBatchesReturnsErrorIfOffsetIsUsed() {
+	ts.createProduct("", 1, 0, false, nil)
+
+	calls := 0
+
+	err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Offset(1).FindInBatches(1, func(_ []*models.Product, _ int) error {
+		calls++
+
+		return nil
+	})
+	ts.ErrorIs(err, cql.ErrKeysetOffsetNotAllowed)
+	ts.ErrorContains(err, "method: FindInBatches")
+	ts.Equal(0, calls)
+}
+
+func (ts *QueryIntTestSuite) TestFindInBatchesReturnsErrorIfBatchSizeIsNotPositive() {
+	ts.createProduct("", 1, 0, false, nil)
+
+	for _, batchSize := range []int{0, -1} {
+		calls := 0
+
+		err := cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		).FindInBatches(batchSize, func(_ []*models.Product, _ int) error {
+			calls++
+
+			return nil
+		})
+		ts.ErrorIs(err, cql.ErrInvalidBatchSize)
+		ts.ErrorContains(err, "method: FindInBatches")
+		ts.Equal(0, calls)
+	}
+}
+
+// ------------------------- Paginate --------------------------------
+
+func (ts *QueryIntTestSuite) TestPaginateReturnsFirstPageWithoutToken() {
+	product1 := ts.createProduct("", 1, 0, false, nil)
+	product2 := ts.createProduct("", 2, 0, false, nil)
+	ts.createProduct("", 3, 0, false, nil)
+
+	page, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Ascending(conditions.Product.Int).Paginate(2, "")
+	ts.Require().NoError(err)
+
+	assert.DeepEqual(ts.T(), []*models.Product{product1, product2}, page.Items)
+	ts.NotEmpty(page.NextToken)
+	ts.Empty(page.PrevToken)
+}
+
+func (ts *QueryIntTestSuite) TestPaginateReturnsNoTokensIfOnlyOnePage() {
+	product1 := ts.createProduct("", 1, 0, false, nil)
+	product2 := ts.createProduct("", 2, 0, false, nil)
+
+	page, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Ascending(conditions.Product.Int).Paginate(2, "")
+	ts.Require().NoError(err)
+
+	assert.DeepEqual(ts.T(), []*models.Product{product1, product2}, page.Items)
+	ts.Empty(page.NextToken)
+	ts.Empty(page.PrevToken)
+}
+
+func (ts *QueryIntTestSuite) TestPaginateReturnsErrorIfPageSizeIsNotPositive() {
+	ts.createProduct("", 1, 0, false, nil)
+
+	for _, pageSize := range []int{0, -1} {
+		page, err := cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		).Paginate(pageSize, "")
+		ts.ErrorIs(err, cql.ErrInvalidBatchSize)
+		ts.ErrorContains(err, "method: Paginate")
+		ts.Nil(page)
+	}
+}
+
+func (ts *QueryIntTestSuite) TestPaginateNextAndPrevTokens() {
+	product1 := ts.createProduct("", 5, 0, false, nil)
+	product2 := ts.createProduct("", 4, 0, false, nil)
+	product3 := ts.createProduct("", 3, 0, false, nil)
+	product4 := ts.createProduct("", 3, 0, false, nil)
+	product5 := ts.createProduct("", 1, 0, false, nil)
+
+	newQuery := func() *condition.Query[models.Product] {
+		return cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		).Descending(conditions.Product.Int)
+	}
+
+	page1, err := newQuery().Paginate(2, "")
+	ts.Require().NoError(err)
+	assert.DeepEqual(ts.T(), []*models.Product{product1, product2}, page1.Items)
+
+	page2, err := newQuery().Paginate(2, page1.NextToken)
+	ts.Require().NoError(err)
+	ts.Require().Len(page2.Items, 2)
+	EqualList(&ts.Suite, []*models.Product{product3, product4}, page2.Items)
+	ts.NotEmpty(page2.NextToken)
+	ts.NotEmpty(page2.PrevToken)
+
+	page3, err := newQuery().Paginate(2, page2.NextToken)
+	ts.Require().NoError(err)
+	assert.DeepEqual(ts.T(), []*models.Product{product5}, page3.Items)
+	ts.Empty(page3.NextToken)
+	ts.NotEmpty(page3.PrevToken)
+
+	page2Again, err := newQuery().Paginate(2, page3.PrevToken)
+	ts.Require().NoError(err)
+	assert.DeepEqual(ts.T(), page2.Items, page2Again.Items)
+	ts.NotEmpty(page2Again.NextToken)
+	ts.NotEmpty(page2Again.PrevToken)
+
+	page1Again, err := newQuery().Paginate(2, page2Again.PrevToken)
+	ts.Require().NoError(err)
+	assert.DeepEqual(ts.T(), page1.Items, page1Again.Items)
+	ts.NotEmpty(page1Again.NextToken)
+	ts.Empty(page1Again.PrevToken)
+}
+
+func (ts *QueryIntTestSuite) TestPaginateReturnsErrorIfTokenIsForADifferentOrder() {
+	ts.createProduct("", 1, 0, false, nil)
+	ts.createProduct("", 2, 0, false, nil)
+
+	page, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Ascending(conditions.Product.Int).Paginate(1, "")
+	ts.Require().NoError(err)
+
+	_, err = cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Descending(conditions.Product.Int).Paginate(1, page.NextToken)
+	ts.ErrorIs(err, cql.ErrPageTokenOrderMismatch)
+}
+
+func (ts *QueryIntTestSuite) TestPaginateReturnsErrorIfTokenIsInvalid() {
+	_, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Paginate(1, "not a token")
+	ts.ErrorIs(err, cql.ErrInvalidPageToken)
+}
@@ -0,0 +1,289 @@
+package test
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type SaveIntTestSuite struct {
+	testSuite
+}
+
+func NewSaveIntTestSuite(
+	db *cql.DB,
+) *SaveIntTestSuite {
+	return &SaveIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *SaveIntTestSuite) TestSaveUpdatesOnlyChangedFields() {
+	product := ts.createProduct("product", 1, 0, false, nil)
+
+	loaded, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("product")),
+	).FindOneTracked()
+	ts.Require().NoError(err)
+
+	// other field is modified after the product is loaded
+	_, err = cql.Update[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("product")),
+	).Set(
+		conditions.Product.Int.Set().Eq(cql.Int(2)),
+	)
+	ts.Require().NoError(err)
+
+	loaded.Model.Float = 1
+
+	err = cql.SaveTracked(context.Background(), ts.db, loaded)
+	ts.Require().NoError(err)
+	ts.True(loaded.Model.UpdatedAt.After(product.UpdatedAt))
+
+	productReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("product")),
+	).FindOne()
+	ts.Require().NoError(err)
+	ts.Equal(float64(1), productReturned.Float)
+	ts.Equal(2, productReturned.Int)
+	ts.True(productReturned.UpdatedAt.After(product.UpdatedAt))
+}
+
+func (ts *SaveIntTestSuite) TestSaveWithoutChangesDoesNotUpdate() {
+	product := ts.createProduct("product", 1, 0, false, nil)
+
+	loaded, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("product")),
+	).FindOneTracked()
+	ts.Require().NoError(err)
+
+	err = cql.SaveTracked(context.Background(), ts.db, loaded)
+	ts.Require().NoError(err)
+
+	productReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("product")),
+	).FindOne()
+	ts.Require().NoError(err)
+	ts.True(productReturned.UpdatedAt.Equal(product.UpdatedAt))
+}
+
+func (ts *SaveIntTestSuite) TestSaveTwiceUpdatesOnlyNewChanges() {
+	ts.createProduct("product", 1, 0, false, nil)
+
+	loaded, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("product")),
+	).FindOneTracked()
+	ts.Require().NoError(err)
+
+	loaded.Model.Float = 1
+
+	err = cql.SaveTracked(context.Background(), ts.db, loaded)
+	ts.Require().NoError(err)
+
+	// other field is modified after the first save
+	_, err = cql.Update[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("product")),
+	).Set(
+		conditions.Product.Float.Set().Eq(cql.Float64(3)),
+	)
+	ts.Require().NoError(err)
+
+	loaded.Model.Int = 2
+
+	err = cql.SaveTracked(context.Background(), ts.db, loaded)
+	ts.Require().NoError(err)
+
+	productReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("product")),
+	).FindOne()
+	ts.Require().NoError(err)
+	ts.Equal(2, productReturned.Int)
+	ts.Equal(float64(3), productReturned.Float)
+}
+
+func (ts *SaveIntTestSuite) TestSaveNotTrackedUpdatesAllFields() {
+	ts.createProduct("product", 1, 0, false, nil)
+
+	loaded, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("product")),
+	).FindOne()
+	ts.Require().NoError(err)
+
+	// other field is modified after the product is loaded
+	_, err = cql.Update[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("product")),
+	).Set(
+		conditions.Product.Int.Set().Eq(cql.Int(2)),
+	)
+	ts.Require().NoError(err)
+
+	loaded.Float = 1
+
+	err = cql.Save(context.Background(), ts.db, loaded)
+	ts.Require().NoError(err)
+
+	productReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("product")),
+	).FindOne()
+	ts.Require().NoError(err)
+	ts.Equal(float64(1), productReturned.Float)
+	// the value loaded is also saved, as the product is not tracked
+	ts.Equal(1, productReturned.Int)
+}
+
+func (ts *SaveIntTestSuite) TestSaveInsertsModelNotLoaded() {
+	brand := &models.Brand{Name: "brand"}
+
+	err := cql.Save(context.Background(), ts.db, brand)
+	ts.Require().NoError(err)
+	ts.True(brand.IsLoaded())
+
+	brandsReturned, err := cql.Query[models.Brand](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []*models.Brand{brand}, brandsReturned)
+}
+
+func (ts *SaveIntTestSuite) TestSaveWithoutCascadeDoesNotSaveRelations() {
+	product := ts.createProduct("product", 1, 0, false, nil)
+	ts.createSale(1, product, nil)
+
+	loaded, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Code.Is().Eq(cql.Int(1)),
+		conditions.Sale.Product().Preload(),
+	).FindOne()
+	ts.Require().NoError(err)
+
+	loaded.Description = "description"
+	loaded.Product.Int = 2
+
+	err = cql.Save(context.Background(), ts.db, loaded)
+	ts.Require().NoError(err)
+
+	saleReturned, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Code.Is().Eq(cql.Int(1)),
+		conditions.Sale.Product().Preload(),
+	).FindOne()
+	ts.Require().NoError(err)
+	ts.Equal("description", saleReturned.Description)
+	ts.Equal(1, saleReturned.Product.Int)
+}
+
+func (ts *SaveIntTestSuite) TestSaveWithCascadeSavesPreloadedRelations() {
+	product := ts.createProduct("product", 1, 0, false, nil)
+	ts.createSale(1, product, ts.createSeller("seller", nil))
+
+	loaded, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Code.Is().Eq(cql.Int(1)),
+		conditions.Sale.Product().Preload(),
+		conditions.Sale.Seller().Preload(),
+	).FindOneTracked()
+	ts.Require().NoError(err)
+
+	loaded.Model.Description = "description"
+	loaded.Model.Product.Int = 2
+	loaded.Model.Seller.Name = "seller2"
+
+	err = cql.SaveTracked(context.Background(), ts.db, loaded, cql.Cascade())
+	ts.Require().NoError(err)
+	ts.True(loaded.Model.Product.UpdatedAt.After(product.UpdatedAt))
+
+	saleReturned, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Code.Is().Eq(cql.Int(1)),
+		conditions.Sale.Product().Preload(),
+		conditions.Sale.Seller().Preload(),
+	).FindOne()
+	ts.Require().NoError(err)
+	ts.Equal("description", saleReturned.Description)
+	ts.Equal(2, saleReturned.Product.Int)
+	ts.Equal("seller2", saleReturned.Seller.Name)
+}
+
+func (ts *SaveIntTestSuite) TestSaveVersionedModel() {
+	ts.createAccount("account", 0)
+
+	loaded, err := cql.Query[models.Account](
+		context.Background(),
+		ts.db,
+		conditions.Account.Name.Is().Eq(cql.String("account")),
+	).FindOne()
+	ts.Require().NoError(err)
+
+	loaded.Balance = 1
+
+	err = cql.Save(context.Background(), ts.db, loaded)
+	ts.Require().NoError(err)
+	ts.Equal(uint(1), loaded.Version)
+
+	accountReturned, err := cql.Query[models.Account](
+		context.Background(),
+		ts.db,
+		conditions.Account.Name.Is().Eq(cql.String("account")),
+	).FindOne()
+	ts.Require().NoError(err)
+	ts.Equal(1, accountReturned.Balance)
+	ts.Equal(uint(1), accountReturned.Version)
+}
+
+func (ts *SaveIntTestSuite) TestSaveVersionedModelReturnsErrorIfModelIsStale() {
+	ts.createAccount("account", 0)
+
+	loaded, err := cql.Query[models.Account](
+		context.Background(),
+		ts.db,
+		conditions.Account.Name.Is().Eq(cql.String("account")),
+	).FindOneTracked()
+	ts.Require().NoError(err)
+
+	_, err = cql.Update[models.Account](
+		context.Background(),
+		ts.db,
+		conditions.Account.Name.Is().Eq(cql.String("account")),
+	).Set(
+		conditions.Account.Balance.Set().Eq(cql.Int(2)),
+	)
+	ts.Require().NoError(err)
+
+	loaded.Model.Balance = 1
+
+	err = cql.SaveTracked(context.Background(), ts.db, loaded)
+	ts.ErrorIs(err, cql.ErrStaleObject)
+	ts.ErrorContains(err, "method: SaveTracked")
+	ts.Equal(uint(0), loaded.Model.Version)
+}
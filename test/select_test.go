@@ -444,3 +444,194 @@ func (ts *SelectIntTestSuite) TestSelectAggregationsAndNotAggregations() {
 		}, results)
 	}
 }
+
+func (ts *SelectIntTestSuite) TestSelectRowNumberPartitioned() {
+	ts.createProduct("a", 1, 0, false, nil)
+	ts.createProduct("a", 3, 0, false, nil)
+	ts.createProduct("a", 2, 0, false, nil)
+	ts.createProduct("b", 5, 0, false, nil)
+	ts.createProduct("b", 4, 0, false, nil)
+
+	results, err := cql.Select(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+		cql.ValueInto(conditions.Product.String, func(value string, result *Result) {
+			result.String = value
+		}),
+		cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+			result.Int = int(value)
+		}),
+		cql.ValueInto(
+			cql.RowNumber().PartitionBy(conditions.Product.String).Descending(conditions.Product.Int),
+			func(value float64, result *Result) {
+				result.Aggregation1 = int(value)
+			},
+		),
+	)
+
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []Result{
+		{String: "a", Int: 3, Aggregation1: 1},
+		{String: "a", Int: 2, Aggregation1: 2},
+		{String: "a", Int: 1, Aggregation1: 3},
+		{String: "b", Int: 5, Aggregation1: 1},
+		{String: "b", Int: 4, Aggregation1: 2},
+	}, results)
+}
+
+func (ts *SelectIntTestSuite) TestSelectRankAndDenseRank() {
+	ts.createProduct("a", 3, 0, false, nil)
+	ts.createProduct("b", 3, 0, false, nil)
+	ts.createProduct("c", 2, 0, false, nil)
+
+	results, err := cql.Select(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+		cql.ValueInto(conditions.Product.String, func(value string, result *Result) {
+			result.String = value
+		}),
+		cql.ValueInto(cql.Rank().Descending(conditions.Product.Int), func(value float64, result *Result) {
+			result.Aggregation1 = int(value)
+		}),
+		cql.ValueInto(cql.DenseRank().Descending(conditions.Product.Int), func(value float64, result *Result) {
+			result.Aggregation2 = int(value)
+		}),
+	)
+
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []Result{
+		{String: "a", Aggregation1: 1, Aggregation2: 1},
+		{String: "b", Aggregation1: 1, Aggregation2: 1},
+		{String: "c", Aggregation1: 3, Aggregation2: 2},
+	}, results)
+}
+
+func (ts *SelectIntTestSuite) TestSelectLagAndLead() {
+	ts.createProduct("a", 1, 0, false, nil)
+	ts.createProduct("b", 2, 0, false, nil)
+	ts.createProduct("c", 3, 0, false, nil)
+
+	results, err := cql.Select(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+		cql.ValueInto(conditions.Product.String, func(value string, result *Result) {
+			result.String = value
+		}),
+		cql.ValueInto(
+			cql.Lag(conditions.Product.Int, 1, cql.Int(0)).Ascending(conditions.Product.Int),
+			func(value float64, result *Result) {
+				result.Aggregation1 = int(value)
+			},
+		),
+		cql.ValueInto(
+			cql.Lead(conditions.Product.String, 2, cql.String("none")).Ascending(conditions.Product.Int),
+			func(value string, result *Result) {
+				result.Aggregation5 = value
+			},
+		),
+	)
+
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []Result{
+		{String: "a", Aggregation1: 0, Aggregation5: "c"},
+		{String: "b", Aggregation1: 1, Aggregation5: "none"},
+		{String: "c", Aggregation1: 2, Aggregation5: "none"},
+	}, results)
+}
+
+func (ts *SelectIntTestSuite) TestSelectRunningSum() {
+	ts.createProduct("a", 1, 0, false, nil)
+	ts.createProduct("a", 2, 0, false, nil)
+	ts.createProduct("a", 3, 0, false, nil)
+	ts.createProduct("b", 4, 0, false, nil)
+
+	results, err := cql.Select(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+		cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+			result.Int = int(value)
+		}),
+		cql.ValueInto(
+			conditions.Product.Int.Aggregate().Sum().Over().
+				PartitionBy(conditions.Product.String).
+				Ascending(conditions.Product.Int).
+				Rows(cql.UnboundedPreceding(), cql.CurrentRow()),
+			func(value float64, result *Result) {
+				result.Aggregation1 = int(value)
+			},
+		),
+	)
+
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []Result{
+		{Int: 1, Aggregation1: 1},
+		{Int: 2, Aggregation1: 3},
+		{Int: 3, Aggregation1: 6},
+		{Int: 4, Aggregation1: 4},
+	}, results)
+}
+
+func (ts *SelectIntTestSuite) TestSelectWindowWithFrame() {
+	ts.createProduct("a", 1, 0, false, nil)
+	ts.createProduct("b", 2, 0, false, nil)
+	ts.createProduct("c", 3, 0, false, nil)
+	ts.createProduct("d", 4, 0, false, nil)
+
+	results, err := cql.Select(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+		cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+			result.Int = int(value)
+		}),
+		cql.ValueInto(
+			conditions.Product.Int.Aggregate().Max().Over().
+				Ascending(conditions.Product.Int).
+				Rows(cql.Preceding(1), cql.Following(1)),
+			func(value float64, result *Result) {
+				result.Aggregation1 = int(value)
+			},
+		),
+		cql.ValueInto(
+			cql.CountAll().Over(),
+			func(value float64, result *Result) {
+				result.Aggregation2 = int(value)
+			},
+		),
+	)
+
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []Result{
+		{Int: 1, Aggregation1: 2, Aggregation2: 4},
+		{Int: 2, Aggregation1: 3, Aggregation2: 4},
+		{Int: 3, Aggregation1: 4, Aggregation2: 4},
+		{Int: 4, Aggregation1: 4, Aggregation2: 4},
+	}, results)
+}
+
+func (ts *SelectIntTestSuite) TestSelectWindowPartitionByNotJoinedModelReturnsError() {
+	_, err := cql.Select(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+		cql.ValueInto(
+			cql.RowNumber().PartitionBy(conditions.Sale.Code),
+			func(value float64, result *Result) {
+				result.Aggregation1 = int(value)
+			},
+		),
+	)
+
+	ts.ErrorIs(err, cql.ErrFieldModelNotConcerned)
+	ts.ErrorContains(err, "not concerned model: models.Sale")
+}
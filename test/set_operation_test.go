@@ -0,0 +1,415 @@
+package test
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type SetOperationIntTestSuite struct {
+	testSuite
+}
+
+func NewSetOperationIntTestSuite(
+	db *cql.DB,
+) *SetOperationIntTestSuite {
+	return &SetOperationIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *SetOperationIntTestSuite) TestUnion() {
+	match1 := ts.createProduct("match", 1, 0, false, nil)
+	match2 := ts.createProduct("other", 2, 0, false, nil)
+	match3 := ts.createProduct("match", 2, 0, false, nil)
+
+	ts.createProduct("not_match", 3, 0, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("match")),
+	).Union(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(cql.Int(2)),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match1, match2, match3}, entities)
+}
+
+func (ts *SetOperationIntTestSuite) TestUnionAll() {
+	match1 := ts.createProduct("match", 1, 0, false, nil)
+	match2 := ts.createProduct("other", 2, 0, false, nil)
+
+	ts.createProduct("not_match", 3, 0, false, nil)
+
+	count, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Lt(cql.Int(3)),
+	).UnionAll(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(cql.Int(2)),
+		),
+	).Count()
+	ts.Require().NoError(err)
+	ts.Equal(int64(3), count)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Lt(cql.Int(3)),
+	).UnionAll(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(cql.Int(2)),
+		),
+	).Descending(conditions.Product.Int).Find()
+	ts.Require().NoError(err)
+
+	ts.Require().Len(entities, 3)
+	ts.Equal(match2.ID, entities[0].ID)
+	ts.Equal(match2.ID, entities[1].ID)
+	ts.Equal(match1.ID, entities[2].ID)
+}
+
+func (ts *SetOperationIntTestSuite) TestIntersect() {
+	match := ts.createProduct("match", 2, 0, false, nil)
+
+	ts.createProduct("match", 1, 0, false, nil)
+	ts.createProduct("not_match", 2, 0, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("match")),
+	).Intersect(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(cql.Int(2)),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match}, entities)
+}
+
+func (ts *SetOperationIntTestSuite) TestExcept() {
+	match := ts.createProduct("match", 1, 0, false, nil)
+
+	ts.createProduct("match", 2, 0, false, nil)
+	ts.createProduct("not_match", 1, 0, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("match")),
+	).Except(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(cql.Int(2)),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match}, entities)
+}
+
+func (ts *SetOperationIntTestSuite) TestUnionWithConditionsOrderAndLimitOnResult() {
+	ts.createProduct("match", 1, 0, false, nil)
+	ts.createProduct("other", 3, 0, false, nil)
+	match := ts.createProduct("match", 2, 0, false, nil)
+
+	ts.createProduct("not_match", 4, 0, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.String.Is().Eq(cql.String("match")),
+	).Union(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(cql.Int(3)),
+		),
+	).Descending(conditions.Product.Int).Limit(2).Offset(1).Find()
+	ts.Require().NoError(err)
+
+	ts.Require().Len(entities, 2)
+	ts.Equal(match.ID, entities[0].ID)
+}
+
+func (ts *SetOperationIntTestSuite) TestUnionOfThreeQueries() {
+	match1 := ts.createProduct("", 1, 0, false, nil)
+	match2 := ts.createProduct("", 2, 0, false, nil)
+	match3 := ts.createProduct("", 3, 0, false, nil)
+
+	ts.createProduct("", 4, 0, false, nil)
+
+	entities, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(1)),
+	).Union(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(cql.Int(2)),
+		),
+	).Union(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(cql.Int(3)),
+		),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Product{match1, match2, match3}, entities)
+}
+
+func (ts *SetOperationIntTestSuite) TestUnionWithErrorReturnsError() {
+	_, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Union(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(conditions.Sale.Code),
+		),
+	).Find()
+	ts.ErrorIs(err, cql.ErrFieldModelNotConcerned)
+}
+
+func (ts *SetOperationIntTestSuite) TestUnionWithOrderOrLimitOnCombinedQueryReturnsError() {
+	_, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Union(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		).Descending(conditions.Product.Int),
+	).Find()
+	ts.ErrorIs(err, cql.ErrSetOperationOrderOrLimit)
+	ts.ErrorContains(err, "set operation: Union")
+
+	_, err = cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Limit(1).Union(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+	).Find()
+	ts.ErrorIs(err, cql.ErrSetOperationOrderOrLimit)
+	ts.ErrorContains(err, "set operation: Union")
+}
+
+func (ts *SetOperationIntTestSuite) TestSelectUnion() {
+	ts.createProduct("1", 1, 0, false, nil)
+	ts.createProduct("2", 2, 0, false, nil)
+	ts.createProduct("3", 2, 0, false, nil)
+	ts.createProduct("4", 3, 0, false, nil)
+
+	results, err := cql.SelectQuery(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Lt(cql.Int(3)),
+		),
+		cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+			result.Int = int(value)
+		}),
+	).Union(
+		cql.SelectQuery(
+			cql.Query[models.Product](
+				context.Background(),
+				ts.db,
+				conditions.Product.Int.Is().Gt(cql.Int(1)),
+			),
+			cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+				result.Int = int(value)
+			}),
+		),
+	).Descending(0).Find()
+	ts.Require().NoError(err)
+
+	ts.True(reflect.DeepEqual([]Result{
+		{Int: 3},
+		{Int: 2},
+		{Int: 1},
+	}, results))
+}
+
+func (ts *SetOperationIntTestSuite) TestSelectUnionAllWithLimit() {
+	ts.createProduct("1", 1, 0, false, nil)
+	ts.createProduct("2", 2, 0, false, nil)
+
+	results, err := cql.SelectQuery(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+		cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+			result.Int = int(value)
+		}),
+	).UnionAll(
+		cql.SelectQuery(
+			cql.Query[models.Product](
+				context.Background(),
+				ts.db,
+			),
+			cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+				result.Int = int(value)
+			}),
+		),
+	).Ascending(0).Limit(3).Find()
+	ts.Require().NoError(err)
+
+	ts.True(reflect.DeepEqual([]Result{
+		{Int: 1},
+		{Int: 1},
+		{Int: 2},
+	}, results))
+}
+
+func (ts *SetOperationIntTestSuite) TestSelectIntersectAndExcept() {
+	product := ts.createProduct("", 0, 0, false, nil)
+
+	ts.createSale(1, product, nil)
+	ts.createSale(2, product, nil)
+	ts.createSale(3, product, nil)
+
+	selectCodes := func(queryConditions ...condition.Condition[models.Sale]) *condition.SelectQuery[Result] {
+		return cql.SelectQuery(
+			cql.Query[models.Sale](
+				context.Background(),
+				ts.db,
+				queryConditions...,
+			),
+			cql.ValueInto(conditions.Sale.Code, func(value float64, result *Result) {
+				result.Int = int(value)
+			}),
+		)
+	}
+
+	results, err := selectCodes(
+		conditions.Sale.Code.Is().Lt(cql.Int(3)),
+	).Intersect(
+		selectCodes(conditions.Sale.Code.Is().Gt(cql.Int(1))),
+	).Find()
+	ts.Require().NoError(err)
+	ts.Equal([]Result{{Int: 2}}, results)
+
+	results, err = selectCodes().Except(
+		selectCodes(conditions.Sale.Code.Is().Gt(cql.Int(1))),
+	).Find()
+	ts.Require().NoError(err)
+	ts.Equal([]Result{{Int: 1}}, results)
+}
+
+func (ts *SetOperationIntTestSuite) TestSelectOrderOutOfRangeReturnsError() {
+	_, err := cql.SelectQuery(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+		cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+			result.Int = int(value)
+		}),
+	).Descending(1).Find()
+	ts.ErrorIs(err, cql.ErrSelectionOutOfRange)
+	ts.ErrorContains(err, "method: Descending")
+}
+
+func (ts *SetOperationIntTestSuite) TestSelectUnionWithErrorReturnsError() {
+	_, err := cql.SelectQuery(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+		cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+			result.Int = int(value)
+		}),
+	).Union(
+		cql.SelectQuery(
+			cql.Query[models.Product](
+				context.Background(),
+				ts.db,
+			),
+			cql.ValueInto(conditions.Sale.Code, func(value float64, result *Result) {
+				result.Int = int(value)
+			}),
+		),
+	).Find()
+	ts.ErrorIs(err, cql.ErrFieldModelNotConcerned)
+}
+
+func (ts *SetOperationIntTestSuite) TestSelectUnionWithLimitOnCombinedQueryReturnsError() {
+	_, err := cql.SelectQuery(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+		cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+			result.Int = int(value)
+		}),
+	).Union(
+		cql.SelectQuery(
+			cql.Query[models.Product](
+				context.Background(),
+				ts.db,
+			),
+			cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+				result.Int = int(value)
+			}),
+		).Limit(1),
+	).Find()
+	ts.ErrorIs(err, cql.ErrSetOperationOrderOrLimit)
+	ts.ErrorContains(err, "set operation: Union")
+}
+
+func (ts *SetOperationIntTestSuite) TestSelectUnionWithDifferentNumberOfSelectionsReturnsError() {
+	_, err := cql.SelectQuery(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		),
+		cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+			result.Int = int(value)
+		}),
+	).Except(
+		cql.SelectQuery(
+			cql.Query[models.Product](
+				context.Background(),
+				ts.db,
+			),
+			cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+				result.Int = int(value)
+			}),
+			cql.ValueInto(conditions.Product.Float, func(value float64, result *Result) {
+				result.Float = value
+			}),
+		),
+	).Find()
+	ts.ErrorIs(err, cql.ErrSetOperationColumnMismatch)
+	ts.ErrorContains(err, "set operation: Except")
+}
@@ -310,3 +310,244 @@ func (ts *SoftDeleteIntTestSuite) TestSoftDeleteLimitWithoutOrderByReturnsError(
 		ts.ErrorContains(err, "method: Limit")
 	}
 }
+
+func (ts *SoftDeleteIntTestSuite) TestHardDeleteDeletesModelsAlsoIfSoftDeleted() {
+	ts.createProduct("", 0, 0, false, nil)
+	ts.createProduct("", 0, 0, false, nil)
+	product3 := ts.createProduct("", 1, 0, false, nil)
+
+	_, err := cql.Delete[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(0)),
+	).Exec()
+	ts.Require().NoError(err)
+
+	deleted, err := cql.Delete[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(0)),
+	).HardDelete().Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(2), deleted)
+
+	productsReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).WithDeleted().Find()
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []*models.Product{product3}, productsReturned)
+}
+
+func (ts *SoftDeleteIntTestSuite) TestHardDeleteWithJoinedConditions() {
+	product1 := ts.createProduct("", 0, 0, false, nil)
+	product2 := ts.createProduct("", 1, 0, false, nil)
+
+	ts.createSale(0, product1, nil)
+	sale2 := ts.createSale(0, product2, nil)
+
+	deleted, err := cql.Delete[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Product(
+			conditions.Product.Int.Is().Eq(cql.Int(0)),
+		),
+	).HardDelete().Exec()
+
+	switch getDBDialector() {
+	case sql.MySQL:
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+	case sql.Postgres, sql.SQLite, sql.SQLServer:
+		ts.Require().NoError(err)
+		ts.Equal(int64(1), deleted)
+
+		salesReturned, err := cql.Query[models.Sale](
+			context.Background(),
+			ts.db,
+		).WithDeleted().Find()
+		ts.Require().NoError(err)
+		EqualList(&ts.Suite, []*models.Sale{sale2}, salesReturned)
+	}
+}
+
+func (ts *SoftDeleteIntTestSuite) TestHardDeleteKeepsOrderByLimit() {
+	product1 := ts.createProduct("1", 0, 0, false, nil)
+	product2 := ts.createProduct("2", 0, 0, false, nil)
+
+	// delete order by limit only supported for mysql
+	if getDBDialector() != sql.MySQL {
+		_, err := cql.Delete[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Bool.Is().False(),
+		).Ascending(
+			conditions.Product.String,
+		).Limit(1).HardDelete().Exec()
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+		ts.ErrorContains(err, "method: Ascending")
+
+		productsReturned, err := cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		).WithDeleted().Find()
+		ts.Require().NoError(err)
+		EqualList(&ts.Suite, []*models.Product{product1, product2}, productsReturned)
+	} else {
+		deleted, err := cql.Delete[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Bool.Is().False(),
+		).Ascending(
+			conditions.Product.String,
+		).Limit(1).HardDelete().Exec()
+		ts.Require().NoError(err)
+		ts.Equal(int64(1), deleted)
+
+		productsReturned, err := cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		).WithDeleted().Find()
+		ts.Require().NoError(err)
+		EqualList(&ts.Suite, []*models.Product{product2}, productsReturned)
+	}
+}
+
+func (ts *SoftDeleteIntTestSuite) TestHardDeleteKeepsReturning() {
+	switch getDBDialector() {
+	// delete returning only supported for postgres, sqlite, sqlserver
+	case sql.MySQL:
+		_, err := cql.Delete[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(cql.Int(0)),
+		).Returning(nil).HardDelete().Exec()
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+		ts.ErrorContains(err, "method: Returning")
+	case sql.Postgres, sql.SQLite, sql.SQLServer:
+		product := ts.createProduct("", 0, 0, false, nil)
+
+		productsReturned := []models.Product{}
+		deleted, err := cql.Delete[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(cql.Int(0)),
+		).Returning(&productsReturned).HardDelete().Exec()
+		ts.Require().NoError(err)
+		ts.Equal(int64(1), deleted)
+
+		ts.Len(productsReturned, 1)
+		ts.Equal(product.ID, productsReturned[0].ID)
+
+		products, err := cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+		).WithDeleted().Find()
+		ts.Require().NoError(err)
+		ts.Len(products, 0)
+	}
+}
+
+func (ts *SoftDeleteIntTestSuite) TestWithDeletedReturnsAlsoSoftDeletedModels() {
+	product1 := ts.createProduct("", 0, 0, false, nil)
+	product2 := ts.createProduct("", 1, 0, false, nil)
+
+	_, err := cql.Delete[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(0)),
+	).Exec()
+	ts.Require().NoError(err)
+
+	productsReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).WithDeleted().Find()
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []*models.Product{product1, product2}, productsReturned)
+}
+
+func (ts *SoftDeleteIntTestSuite) TestOnlyDeletedReturnsOnlySoftDeletedModels() {
+	product1 := ts.createProduct("", 0, 0, false, nil)
+	ts.createProduct("", 1, 0, false, nil)
+
+	_, err := cql.Delete[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(0)),
+	).Exec()
+	ts.Require().NoError(err)
+
+	productsReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).OnlyDeleted().Find()
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []*models.Product{product1}, productsReturned)
+}
+
+func (ts *SoftDeleteIntTestSuite) TestOnlyDeletedReturnsErrorIfModelIsNotSoftDeletable() {
+	_, err := cql.Query[models.Brand](
+		context.Background(),
+		ts.db,
+	).OnlyDeleted().Find()
+	ts.ErrorIs(err, cql.ErrNotSoftDeletable)
+	ts.ErrorContains(err, "method: OnlyDeleted")
+}
+
+func (ts *SoftDeleteIntTestSuite) TestRestoreRestoresSoftDeletedModels() {
+	product1 := ts.createProduct("", 0, 0, false, nil)
+	product2 := ts.createProduct("", 1, 0, false, nil)
+	ts.createProduct("", 2, 0, false, nil)
+
+	_, err := cql.Delete[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Lt(cql.Int(2)),
+	).Exec()
+	ts.Require().NoError(err)
+
+	restored, err := cql.Restore[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(0)),
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), restored)
+
+	productsReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Lt(cql.Int(2)),
+	).Find()
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []*models.Product{product1}, productsReturned)
+
+	productsReturned, err = cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).OnlyDeleted().Find()
+	ts.Require().NoError(err)
+	EqualList(&ts.Suite, []*models.Product{product2}, productsReturned)
+}
+
+func (ts *SoftDeleteIntTestSuite) TestRestoreDoesNotAffectNotDeletedModels() {
+	ts.createProduct("", 0, 0, false, nil)
+
+	restored, err := cql.Restore[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(0)),
+	).Exec()
+	ts.Require().NoError(err)
+	ts.Equal(int64(0), restored)
+}
+
+func (ts *SoftDeleteIntTestSuite) TestRestoreReturnsErrorIfModelIsNotSoftDeletable() {
+	_, err := cql.Restore[models.Brand](
+		context.Background(),
+		ts.db,
+		conditions.Brand.Name.Is().Eq(cql.String("brand")),
+	).Exec()
+	ts.ErrorIs(err, cql.ErrNotSoftDeletable)
+	ts.ErrorContains(err, "method: Restore")
+}
@@ -145,3 +145,69 @@ func (ts *testSuite) createUniversity(name string) *models.University {
 		Name: name,
 	})
 }
+
+func (ts *testSuite) createAccount(name string, balance int) *models.Account {
+	return create(ts, &models.Account{
+		Name:    name,
+		Balance: balance,
+	})
+}
+
+func (ts *testSuite) createSaleArchive(code int, description string) *models.SaleArchive {
+	return create(ts, &models.SaleArchive{
+		Code:        code,
+		Description: description,
+	})
+}
+
+func (ts *testSuite) createWarehouse(zone, number uint, name string) *models.Warehouse {
+	return create(ts, &models.Warehouse{
+		Zone:   zone,
+		Number: number,
+		Name:   name,
+	})
+}
+
+func (ts *testSuite) createStock(warehouse *models.Warehouse, product *models.Product, quantity int) *models.Stock {
+	return create(ts, &models.Stock{
+		Warehouse: *warehouse,
+		Product:   *product,
+		Quantity:  quantity,
+	})
+}
+
+func (ts *testSuite) createCurrency(id model.StringID, name string) *models.Currency {
+	currency := &models.Currency{
+		Name: name,
+	}
+	currency.ID = id
+
+	return create(ts, currency)
+}
+
+func (ts *testSuite) createTicket(title string) *models.Ticket {
+	return create(ts, &models.Ticket{
+		Title: title,
+	})
+}
+
+func (ts *testSuite) createShipment(destination string, ticket *models.Ticket, currency *models.Currency) *models.Shipment {
+	return create(ts, &models.Shipment{
+		Destination: destination,
+		Ticket:      ticket,
+		Currency:    currency,
+	})
+}
+
+func (ts *testSuite) createEvent(name string) *models.Event {
+	return create(ts, &models.Event{
+		Name: name,
+	})
+}
+
+func (ts *testSuite) createDocument(content string, labels map[string]string) *models.Document {
+	return create(ts, &models.Document{
+		Content: model.JSON(content),
+		Labels:  labels,
+	})
+}
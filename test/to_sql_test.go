@@ -0,0 +1,177 @@
+package test
+
+import (
+	"context"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/sql"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type ToSQLIntTestSuite struct {
+	testSuite
+}
+
+func NewToSQLIntTestSuite(
+	db *cql.DB,
+) *ToSQLIntTestSuite {
+	return &ToSQLIntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *ToSQLIntTestSuite) TestQueryToSQLDoesNotExecuteTheQuery() {
+	product := ts.createProduct("match", 1, 0, false, nil)
+	match := ts.createSale(1, product, nil)
+
+	query := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Code.Is().Eq(cql.Int(1)),
+		conditions.Sale.Product(
+			conditions.Product.String.Is().Eq(cql.String("match")),
+		),
+	)
+
+	statementSQL, values, err := query.ToSQL()
+	ts.Require().NoError(err)
+	ts.Contains(statementSQL, "SELECT sales.* FROM")
+	ts.Contains(statementSQL, "INNER JOIN products Product ON Product.id = sales.product_id")
+	ts.Contains(statementSQL, "sales.code = ")
+	ts.Contains(values, "match")
+	ts.Len(values, 2)
+
+	// the query can still be executed
+	entities, err := query.Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Sale{match}, entities)
+}
+
+func (ts *ToSQLIntTestSuite) TestQueryToSQLWithErrorReturnsError() {
+	_, _, err := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Code.Is().Eq(conditions.Product.Int),
+	).ToSQL()
+	ts.ErrorIs(err, cql.ErrFieldModelNotConcerned)
+}
+
+func (ts *ToSQLIntTestSuite) TestUpdateToSQLDoesNotUpdate() {
+	product := ts.createProduct("", 1, 0, false, nil)
+
+	update := cql.Update[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(1)),
+	)
+
+	statementSQL, values, err := update.ToSQL(
+		conditions.Product.Int.Set().Eq(cql.Int(2)),
+	)
+	ts.Require().NoError(err)
+	ts.Contains(statementSQL, "UPDATE ")
+	ts.Contains(statementSQL, "products.int = ")
+	ts.NotEmpty(values)
+
+	productReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(1)),
+	).FindOne()
+	ts.Require().NoError(err)
+	ts.Equal(product.ID, productReturned.ID)
+
+	updated, err := update.Set(
+		conditions.Product.Int.Set().Eq(cql.Int(2)),
+	)
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), updated)
+}
+
+func (ts *ToSQLIntTestSuite) TestDeleteToSQLDoesNotDelete() {
+	ts.createProduct("", 1, 0, false, nil)
+	ts.createProductNoTimestamps("", 1, 0, false, nil)
+
+	statementSQL, _, err := cql.Delete[models.Product](
+		context.Background(),
+		ts.db,
+		conditions.Product.Int.Is().Eq(cql.Int(1)),
+	).ToSQL()
+	ts.Require().NoError(err)
+	// soft delete
+	ts.Contains(statementSQL, "UPDATE ")
+	ts.Contains(statementSQL, "deleted_at")
+
+	statementSQL, _, err = cql.Delete[models.ProductNoTimestamps](
+		context.Background(),
+		ts.db,
+		conditions.ProductNoTimestamps.Int.Is().Eq(cql.Int(1)),
+	).ToSQL()
+	ts.Require().NoError(err)
+	ts.Contains(statementSQL, "DELETE FROM ")
+	ts.Contains(statementSQL, "product_no_timestamps.int = ")
+
+	count, err := cql.Query[models.Product](context.Background(), ts.db).Count()
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), count)
+
+	count, err = cql.Query[models.ProductNoTimestamps](context.Background(), ts.db).Count()
+	ts.Require().NoError(err)
+	ts.Equal(int64(1), count)
+}
+
+func (ts *ToSQLIntTestSuite) TestInsertToSQLDoesNotInsert() {
+	statementSQL, values, err := cql.Insert(
+		context.Background(),
+		ts.db,
+		&models.Product{Int: 1},
+	).ToSQL()
+	ts.Require().NoError(err)
+	ts.Contains(statementSQL, "INSERT INTO ")
+	ts.Contains(values, 1)
+
+	count, err := cql.Query[models.Product](context.Background(), ts.db).Count()
+	ts.Require().NoError(err)
+	ts.Equal(int64(0), count)
+}
+
+func (ts *ToSQLIntTestSuite) TestSelectToSQL() {
+	statementSQL, values, err := cql.SelectQuery(
+		cql.Query[models.Product](
+			context.Background(),
+			ts.db,
+			conditions.Product.Int.Is().Eq(cql.Int(1)),
+		),
+		cql.ValueInto(conditions.Product.Int, func(value float64, result *Result) {
+			result.Int = int(value)
+		}),
+	).ToSQL()
+	ts.Require().NoError(err)
+	ts.Contains(statementSQL, "SELECT products.int FROM ")
+	ts.Len(values, 1)
+}
+
+func (ts *ToSQLIntTestSuite) TestExplain() {
+	query := cql.Query[models.Sale](
+		context.Background(),
+		ts.db,
+		conditions.Sale.Product(
+			conditions.Product.Int.Is().Eq(cql.Int(1)),
+		),
+	)
+
+	switch getDBDialector() {
+	case sql.SQLServer:
+		_, err := query.Explain()
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+		ts.ErrorContains(err, "method: Explain")
+	case sql.Postgres, sql.MySQL, sql.SQLite:
+		plan, err := query.Explain()
+		ts.Require().NoError(err)
+		ts.NotEmpty(plan)
+	}
+}
@@ -2,10 +2,17 @@ package test
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"time"
 
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	mssql "github.com/microsoft/go-mssqldb"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/FrancoLiberali/cql"
+	cqlSQL "github.com/FrancoLiberali/cql/sql"
 	"github.com/FrancoLiberali/cql/test/conditions"
 	"github.com/FrancoLiberali/cql/test/models"
 )
@@ -84,3 +91,298 @@ func (ts *TransactionIntTestSuite) TestTransactionFail() {
 	ts.Require().NoError(err)
 	ts.Empty(productsReturned)
 }
+
+func (ts *TransactionIntTestSuite) TestSavePointAndRollbackTo() {
+	err := ts.db.Transaction(context.Background(), func(tx *cql.DB) error {
+		_, err := cql.Insert(context.Background(), tx, &models.Product{
+			Int: 1,
+		}).Exec()
+		ts.Require().NoError(err)
+
+		err = tx.SavePoint(context.Background(), "before_second")
+		ts.Require().NoError(err)
+
+		_, err = cql.Insert(context.Background(), tx, &models.Product{
+			Int: 2,
+		}).Exec()
+		ts.Require().NoError(err)
+
+		return tx.RollbackTo(context.Background(), "before_second")
+	})
+	ts.Require().NoError(err)
+
+	productsReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	ts.Require().Len(productsReturned, 1)
+	ts.Equal(1, productsReturned[0].Int)
+}
+
+func (ts *TransactionIntTestSuite) TestSavePointOutsideTransactionReturnsError() {
+	err := ts.db.SavePoint(context.Background(), "savepoint")
+	ts.ErrorIs(err, cql.ErrNotInTransaction)
+
+	err = ts.db.RollbackTo(context.Background(), "savepoint")
+	ts.ErrorIs(err, cql.ErrNotInTransaction)
+}
+
+func (ts *TransactionIntTestSuite) TestNestedTransactionOK() {
+	err := ts.db.Transaction(context.Background(), func(tx *cql.DB) error {
+		_, err := cql.Insert(context.Background(), tx, &models.Product{
+			Int: 1,
+		}).Exec()
+		ts.Require().NoError(err)
+
+		return tx.Transaction(context.Background(), func(nestedTx *cql.DB) error {
+			_, err := cql.Insert(context.Background(), nestedTx, &models.Product{
+				Int: 2,
+			}).Exec()
+
+			return err
+		})
+	})
+	ts.Require().NoError(err)
+
+	count, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Count()
+	ts.Require().NoError(err)
+	ts.Equal(int64(2), count)
+}
+
+func (ts *TransactionIntTestSuite) TestNestedTransactionFailRollsBackOnlyNestedWork() {
+	err := ts.db.Transaction(context.Background(), func(tx *cql.DB) error {
+		_, err := cql.Insert(context.Background(), tx, &models.Product{
+			Int: 1,
+		}).Exec()
+		ts.Require().NoError(err)
+
+		err = tx.Transaction(context.Background(), func(nestedTx *cql.DB) error {
+			_, err := cql.Insert(context.Background(), nestedTx, &models.Product{
+				Int: 2,
+			}).Exec()
+			ts.Require().NoError(err)
+
+			return assert.AnError
+		})
+		ts.Require().ErrorIs(err, assert.AnError)
+
+		return nil
+	})
+	ts.Require().NoError(err)
+
+	productsReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	ts.Require().Len(productsReturned, 1)
+	ts.Equal(1, productsReturned[0].Int)
+}
+
+func (ts *TransactionIntTestSuite) TestNestedTransactionPanicRollsBackNestedWork() {
+	err := ts.db.Transaction(context.Background(), func(tx *cql.DB) error {
+		_, err := cql.Insert(context.Background(), tx, &models.Product{
+			Int: 1,
+		}).Exec()
+		ts.Require().NoError(err)
+
+		ts.Panics(func() {
+			_ = tx.Transaction(context.Background(), func(nestedTx *cql.DB) error {
+				_, err := cql.Insert(context.Background(), nestedTx, &models.Product{
+					Int: 2,
+				}).Exec()
+				ts.Require().NoError(err)
+
+				panic("nested panic")
+			})
+		})
+
+		return nil
+	})
+	ts.Require().NoError(err)
+
+	productsReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	ts.Require().Len(productsReturned, 1)
+	ts.Equal(1, productsReturned[0].Int)
+}
+
+// returns an error that the database returns for deadlocks
+func deadlockError() error {
+	switch getDBDialector() {
+	case cqlSQL.Postgres:
+		return &pgconn.PgError{Code: "40P01"}
+	case cqlSQL.MySQL:
+		return &mysql.MySQLError{Number: 1213}
+	case cqlSQL.SQLServer:
+		return mssql.Error{Number: 1205}
+	case cqlSQL.SQLite:
+		return errors.New("database is locked")
+	}
+
+	return nil
+}
+
+func (ts *TransactionIntTestSuite) TestTransactionWithRetryRetriesDeadlocks() {
+	attempts := 0
+
+	err := ts.db.Transaction(
+		context.Background(),
+		func(tx *cql.DB) error {
+			attempts++
+
+			_, err := cql.Insert(context.Background(), tx, &models.Product{
+				Int: attempts,
+			}).Exec()
+			ts.Require().NoError(err)
+
+			if attempts < 3 {
+				return deadlockError()
+			}
+
+			return nil
+		},
+		cql.WithRetry(3, time.Millisecond),
+	)
+	ts.Require().NoError(err)
+	ts.Equal(3, attempts)
+
+	// work done in the failed attempts is rolled back
+	productsReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	ts.Require().Len(productsReturned, 1)
+	ts.Equal(3, productsReturned[0].Int)
+}
+
+func (ts *TransactionIntTestSuite) TestTransactionWithTxOptionsAndRetry() {
+	attempts := 0
+
+	err := ts.db.Transaction(
+		context.Background(),
+		func(tx *cql.DB) error {
+			attempts++
+
+			_, err := cql.Insert(context.Background(), tx, &models.Product{
+				Int: attempts,
+			}).Exec()
+			ts.Require().NoError(err)
+
+			if attempts < 2 {
+				return deadlockError()
+			}
+
+			return nil
+		},
+		cql.TxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}),
+		cql.WithRetry(2, time.Millisecond),
+	)
+	ts.Require().NoError(err)
+	ts.Equal(2, attempts)
+
+	productsReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	ts.Require().Len(productsReturned, 1)
+	ts.Equal(2, productsReturned[0].Int)
+}
+
+func (ts *TransactionIntTestSuite) TestTransactionWithRetryReturnsErrorAfterMaxAttempts() {
+	deadlock := deadlockError()
+	attempts := 0
+
+	err := ts.db.Transaction(
+		context.Background(),
+		func(_ *cql.DB) error {
+			attempts++
+
+			return deadlock
+		},
+		cql.WithRetry(2, time.Millisecond),
+	)
+	ts.Require().ErrorIs(err, deadlock)
+	ts.Equal(2, attempts)
+}
+
+func (ts *TransactionIntTestSuite) TestTransactionWithRetryDoesNotRetryOtherErrors() {
+	attempts := 0
+
+	err := ts.db.Transaction(
+		context.Background(),
+		func(_ *cql.DB) error {
+			attempts++
+
+			return assert.AnError
+		},
+		cql.WithRetry(3, time.Millisecond),
+	)
+	ts.Require().ErrorIs(err, assert.AnError)
+	ts.Equal(1, attempts)
+}
+
+func (ts *TransactionIntTestSuite) TestTransactionWithRetryStopsWhenContextIsDone() {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+
+	err := ts.db.Transaction(
+		ctx,
+		func(_ *cql.DB) error {
+			attempts++
+
+			cancel()
+
+			return deadlockError()
+		},
+		cql.WithRetry(3, time.Hour),
+	)
+	ts.Require().ErrorIs(err, context.Canceled)
+	ts.Equal(1, attempts)
+}
+
+func (ts *TransactionIntTestSuite) TestTransactionWithSQLTxOptions() {
+	err := ts.db.Transaction(
+		context.Background(),
+		func(tx *cql.DB) error {
+			_, err := cql.Insert(context.Background(), tx, &models.Product{Int: 1}).Exec()
+
+			return err
+		},
+		&sql.TxOptions{Isolation: sql.LevelSerializable},
+	)
+	ts.Require().NoError(err)
+
+	productsReturned, err := cql.Query[models.Product](
+		context.Background(),
+		ts.db,
+	).Find()
+	ts.Require().NoError(err)
+	ts.Len(productsReturned, 1)
+}
+
+func (ts *TransactionIntTestSuite) TestTransactionWithInvalidOptionReturnsError() {
+	executed := false
+
+	err := ts.db.Transaction(
+		context.Background(),
+		func(_ *cql.DB) error {
+			executed = true
+
+			return nil
+		},
+		sql.LevelSerializable,
+	)
+	ts.ErrorIs(err, cql.ErrInvalidTransactionOption)
+	ts.ErrorContains(err, "option: sql.IsolationLevel")
+	ts.False(executed)
+}
@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/FrancoLiberali/cql"
+	"github.com/FrancoLiberali/cql/model"
+	"github.com/FrancoLiberali/cql/sql"
+	"github.com/FrancoLiberali/cql/test/conditions"
+	"github.com/FrancoLiberali/cql/test/models"
+)
+
+type UUIDv7IntTestSuite struct {
+	testSuite
+}
+
+func NewUUIDv7IntTestSuite(
+	db *cql.DB,
+) *UUIDv7IntTestSuite {
+	return &UUIDv7IntTestSuite{
+		testSuite: testSuite{
+			db: db,
+		},
+	}
+}
+
+func (ts *UUIDv7IntTestSuite) TestUUIDv7ModelGeneratesUUIDv7() {
+	before := time.Now().Truncate(time.Millisecond)
+	event := ts.createEvent("created")
+	after := time.Now()
+
+	ts.Equal(uuid.Version(7), event.ID.Version())
+
+	timestamp, err := event.ID.Timestamp()
+	ts.Require().NoError(err)
+	ts.False(timestamp.Before(before))
+	ts.False(timestamp.After(after))
+
+	events, err := cql.Query[models.Event](
+		context.Background(),
+		ts.db,
+		conditions.Event.ID.Is().Eq(cql.UUID(event.ID)),
+	).Find()
+	ts.Require().NoError(err)
+
+	EqualList(&ts.Suite, []*models.Event{event}, events)
+}
+
+func (ts *UUIDv7IntTestSuite) TestSetUUIDGenerator() {
+	model.SetUUIDGenerator(model.NewUUIDv7)
+	defer model.SetUUIDGenerator(model.NewUUID)
+
+	product := ts.createProduct("", 0, 0, false, nil)
+	ts.Equal(uuid.Version(7), product.ID.Version())
+
+	model.SetUUIDGenerator(model.NewUUID)
+
+	product = ts.createProduct("", 0, 0, false, nil)
+	ts.Equal(uuid.Version(4), product.ID.Version())
+}
+
+func (ts *UUIDv7IntTestSuite) TestUUIDv7Between() {
+	now := time.Now()
+
+	ts.createEventWithIDGeneratedAt("before", now.Add(-time.Hour))
+	match1 := ts.createEventWithIDGeneratedAt("first", now.Add(-time.Minute))
+	match2 := ts.createEventWithIDGeneratedAt("second", now)
+	ts.createEventWithIDGeneratedAt("after", now.Add(time.Hour))
+
+	events, err := cql.Query[models.Event](
+		context.Background(),
+		ts.db,
+		cql.UUIDv7Between[models.Event](conditions.Event.ID, now.Add(-time.Minute), now),
+	).Find()
+
+	switch getDBDialector() {
+	case sql.SQLServer:
+		ts.ErrorIs(err, cql.ErrUnsupportedByDatabase)
+		ts.ErrorContains(err, "method: UUIDv7Between")
+	case sql.Postgres, sql.MySQL, sql.SQLite:
+		ts.Require().NoError(err)
+
+		EqualList(&ts.Suite, []*models.Event{match1, match2}, events)
+	}
+}
+
+func (ts *UUIDv7IntTestSuite) createEventWithIDGeneratedAt(name string, generationTime time.Time) *models.Event {
+	event := &models.Event{
+		Name: name,
+	}
+	// the smallest uuid of the millisecond, to be able to set the generation time
+	event.ID = model.MinUUIDv7(generationTime)
+
+	return create(&ts.testSuite, event)
+}
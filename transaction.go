@@ -3,6 +3,9 @@ package cql
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"gorm.io/gorm"
@@ -10,25 +13,89 @@ import (
 	"github.com/FrancoLiberali/cql/logger"
 )
 
+// TransactionOption modifies the way a transaction is executed.
+// It can be a *sql.TxOptions (to set the isolation level or the read only mode),
+// also obtained using TxOptions, or the result of WithRetry
+// (to retry the transaction in case of serialization failures or deadlocks).
+//
+// Options of any other type make Transaction return ErrInvalidTransactionOption.
+type TransactionOption any
+
+// Configuration of a transaction, set by the TransactionOptions
+type transactionConfig struct {
+	txOptions []*sql.TxOptions
+	retry     *TransactionRetry
+}
+
+// Returns the configuration set by opts or ErrInvalidTransactionOption if one of them is not supported
+func newTransactionConfig(opts []TransactionOption) (transactionConfig, error) {
+	config := transactionConfig{}
+
+	for _, opt := range opts {
+		switch typedOpt := opt.(type) {
+		case nil:
+		case *sql.TxOptions:
+			config.txOptions = []*sql.TxOptions{typedOpt}
+		case *TransactionRetry:
+			config.retry = typedOpt
+		default:
+			return transactionConfig{}, fmt.Errorf("%w; option: %T", ErrInvalidTransactionOption, opt)
+		}
+	}
+
+	return config, nil
+}
+
+// TxOptions sets the options of the transaction, for example its isolation level or read only mode:
+//
+//	db.Transaction(
+//		ctx,
+//		func(tx *cql.DB) error {
+//			...
+//		},
+//		cql.TxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}),
+//	)
+//
+// The *sql.TxOptions can also be passed directly as a TransactionOption.
+func TxOptions(txOptions *sql.TxOptions) TransactionOption {
+	return txOptions
+}
+
 // Transaction executes the function "toExec" inside a transaction
 // The transaction is automatically rolled back in case "toExec" returns an error
-// opts can be used to pass arguments to the transaction
+// opts can be used to pass arguments to the transaction (*sql.TxOptions or TxOptions)
+// or to retry it in case of serialization failures or deadlocks (WithRetry)
+//
+// If db is already a transaction (Transaction is called inside other transaction),
+// a savepoint is created and only the work done by "toExec" is rolled back in case it returns an error
+// (in this case opts are ignored, as the options of the outer transaction are used)
 func (db *DB) Transaction(
 	ctx context.Context,
 	toExec func(*DB) error,
-	opts ...*sql.TxOptions,
+	opts ...TransactionOption,
 ) error {
+	if db.inTransaction() {
+		return db.nestedTransaction(ctx, toExec)
+	}
+
+	config, err := newTransactionConfig(opts)
+	if err != nil {
+		return err
+	}
+
 	begin := time.Now()
 
-	err := db.GormDB.Transaction(
-		func(tx *gorm.DB) error {
-			return toExec(&DB{
-				GormDB:                tx,
-				withLoggerFromContext: db.withLoggerFromContext,
-			})
-		},
-		opts...,
-	)
+	err = db.executeWithRetry(ctx, config.retry, func() error {
+		return db.GormDB.Transaction(
+			func(tx *gorm.DB) error {
+				return toExec(&DB{
+					GormDB:                tx,
+					withLoggerFromContext: db.withLoggerFromContext,
+				})
+			},
+			config.txOptions...,
+		)
+	})
 	if err != nil {
 		return err
 	}
@@ -40,3 +107,88 @@ func (db *DB) Transaction(
 
 	return nil
 }
+
+// amount of nested transactions created, used to generate unique savepoint names
+var nestedTransactions atomic.Uint64
+
+// Executes toExec after creating a savepoint, rolling back to it if toExec returns an error or panics
+func (db *DB) nestedTransaction(ctx context.Context, toExec func(*DB) error) (err error) {
+	savePointName := "cql_nested_" + strconv.FormatUint(nestedTransactions.Add(1), 10)
+
+	err = db.SavePoint(ctx, savePointName)
+	if err != nil {
+		return err
+	}
+
+	panicked := true
+
+	defer func() {
+		if panicked || err != nil {
+			rollbackErr := db.RollbackTo(ctx, savePointName)
+			if err == nil {
+				err = rollbackErr
+			}
+		}
+	}()
+
+	err = toExec(db)
+	panicked = false
+
+	return err
+}
+
+// SavePoint creates a savepoint with the given name in the current transaction,
+// so the work done after it can be undone using RollbackTo without rolling back the whole transaction
+//
+// It can only be used inside a transaction (on the *DB received by the function executed by Transaction),
+// otherwise ErrNotInTransaction is returned
+func (db *DB) SavePoint(ctx context.Context, name string) error {
+	if !db.inTransaction() {
+		return ErrNotInTransaction
+	}
+
+	tx := db.gormDBWithContext(ctx)
+
+	err := tx.SavePoint(name).Error
+	if err != nil {
+		return err
+	}
+
+	loggerInterface, isLoggerInterface := tx.Logger.(logger.Interface)
+	if isLoggerInterface {
+		loggerInterface.TraceSavePoint(ctx, name)
+	}
+
+	return nil
+}
+
+// RollbackTo undoes the work done in the current transaction since the creation of the savepoint with the given name
+//
+// It can only be used inside a transaction (on the *DB received by the function executed by Transaction),
+// otherwise ErrNotInTransaction is returned
+func (db *DB) RollbackTo(ctx context.Context, name string) error {
+	if !db.inTransaction() {
+		return ErrNotInTransaction
+	}
+
+	tx := db.gormDBWithContext(ctx)
+
+	err := tx.RollbackTo(name).Error
+	if err != nil {
+		return err
+	}
+
+	loggerInterface, isLoggerInterface := tx.Logger.(logger.Interface)
+	if isLoggerInterface {
+		loggerInterface.TraceRollbackTo(ctx, name)
+	}
+
+	return nil
+}
+
+// Returns true if db is a transaction
+func (db *DB) inTransaction() bool {
+	committer, isCommitter := db.GormDB.Statement.ConnPool.(gorm.TxCommitter)
+
+	return isCommitter && committer != nil
+}
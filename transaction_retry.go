@@ -0,0 +1,85 @@
+package cql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/FrancoLiberali/cql/logger"
+	"github.com/FrancoLiberali/cql/sql"
+)
+
+// TransactionRetry is a TransactionOption that makes the transaction to be re-executed
+// when it fails because of a serialization failure or a deadlock
+type TransactionRetry struct {
+	maxAttempts uint
+	backoff     time.Duration
+}
+
+// WithRetry makes the transaction to be re-executed (up to a total of maxAttempts executions)
+// when it fails because of a serialization failure or a deadlock.
+//
+// Before each retry, backoff is waited, duplicating it after each attempt (exponential backoff).
+//
+// For example, to retry a serializable transaction up to 3 times:
+//
+//	db.Transaction(
+//		ctx,
+//		func(tx *cql.DB) error {
+//			...
+//		},
+//		cql.TxOptions(&sql.TxOptions{Isolation: sql.LevelSerializable}),
+//		cql.WithRetry(3, 10*time.Millisecond),
+//	)
+func WithRetry(maxAttempts uint, backoff time.Duration) *TransactionRetry {
+	return &TransactionRetry{
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+}
+
+// Returns true if the transaction that failed with err in the attempt number attempt must be retried
+func (retry *TransactionRetry) mustRetry(dialector sql.Dialector, attempt uint, err error) bool {
+	return retry != nil && attempt < retry.maxAttempts && isRetryableError(dialector, err)
+}
+
+// Waits the backoff corresponding to the attempt number attempt
+// or until ctx is done, in which case the error of ctx is returned
+func (retry *TransactionRetry) wait(ctx context.Context, attempt uint) error {
+	timer := time.NewTimer(retry.backoff << (attempt - 1))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Executes the transaction, re-executing it as defined by retry
+func (db *DB) executeWithRetry(
+	ctx context.Context,
+	retry *TransactionRetry,
+	transaction func() error,
+) error {
+	dialector := sql.Dialector(db.GormDB.Dialector.Name())
+
+	err := transaction()
+
+	for attempt := uint(1); err != nil && retry.mustRetry(dialector, attempt, err); attempt++ {
+		loggerInterface, isLoggerInterface := db.gormDBWithContext(ctx).Logger.(logger.Interface)
+		if isLoggerInterface {
+			loggerInterface.TraceTransactionRetry(ctx, attempt, err)
+		}
+
+		waitErr := retry.wait(ctx, attempt)
+		if waitErr != nil {
+			return errors.Join(err, waitErr)
+		}
+
+		err = transaction()
+	}
+
+	return err
+}
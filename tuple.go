@@ -0,0 +1,67 @@
+package cql
+
+import (
+	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// Tuple2 allows the comparison of two values (in general, fields of the model or of joined models)
+// with lists of tuples of values, for example for models with composite keys.
+//
+// The type of each value of the tuples is verified in compilation time,
+// so it must be the same as the type of the corresponding value of the tuple.
+// On the other hand, the model of the fields is not verified against TObject in compilation time,
+// as they can also be fields of joined models:
+// using a field of a model that is not joined by the query returns ErrFieldModelNotConcerned when it is executed.
+//
+// Example:
+//
+//	cql.Query[models.City](
+//		ctx,
+//		db,
+//		cql.Tuple2[models.City](conditions.City.CountryID, conditions.City.Name).In(
+//			cql.Values2(cql.UUID(countryID1), cql.String("Paris")),
+//			cql.Values2(cql.UUID(countryID2), cql.String("Buenos Aires")),
+//		),
+//	)
+//
+// translates as
+//
+// (cities.country_id, cities.name) IN ((countryID1, "Paris"), (countryID2, "Buenos Aires"))
+//
+// or in sqlserver, that does not support tuples,
+//
+// ((cities.country_id = countryID1 AND cities.name = "Paris") OR (cities.country_id = countryID2 AND cities.name = "Buenos Aires"))
+func Tuple2[TObject model.Model, T1, T2 any](
+	value1 condition.ValueOfType[T1],
+	value2 condition.ValueOfType[T2],
+) condition.Tuple2[TObject, T1, T2] {
+	return condition.NewTuple2[TObject](value1, value2)
+}
+
+// Values2 creates a tuple of values to be compared with a Tuple2
+func Values2[T1, T2 any](
+	value1 condition.ValueOfType[T1],
+	value2 condition.ValueOfType[T2],
+) condition.TupleValues2[T1, T2] {
+	return condition.NewTupleValues2(value1, value2)
+}
+
+// Tuple3 allows the comparison of three values (in general, fields of the model or of joined models)
+// with lists of tuples of values, in the same way as Tuple2
+func Tuple3[TObject model.Model, T1, T2, T3 any](
+	value1 condition.ValueOfType[T1],
+	value2 condition.ValueOfType[T2],
+	value3 condition.ValueOfType[T3],
+) condition.Tuple3[TObject, T1, T2, T3] {
+	return condition.NewTuple3[TObject](value1, value2, value3)
+}
+
+// Values3 creates a tuple of values to be compared with a Tuple3
+func Values3[T1, T2, T3 any](
+	value1 condition.ValueOfType[T1],
+	value2 condition.ValueOfType[T2],
+	value3 condition.ValueOfType[T3],
+) condition.TupleValues3[T1, T2, T3] {
+	return condition.NewTupleValues3(value1, value2, value3)
+}
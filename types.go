@@ -74,3 +74,11 @@ func Time(value time.Time) condition.Value[time.Time] {
 func UUID(value model.UUID) condition.Value[model.UUID] {
 	return condition.UUID(value)
 }
+
+func ID[TID model.ID](value TID) condition.Value[TID] {
+	return condition.ID(value)
+}
+
+func JSON(value model.JSON) condition.Value[model.JSON] {
+	return condition.JSON(value)
+}
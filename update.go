@@ -19,3 +19,26 @@ func Update[T model.Model](ctx context.Context, tx *DB, conditions ...condition.
 		conditions,
 	)
 }
+
+// Create a Update of the loaded model (a model previously obtained from the database),
+// identified by its primary key, inside transaction tx.
+//
+// If the model uses optimistic locking (embeds model.Versioned), its version is also verified,
+// so cql.ErrStaleObject is returned if it was modified by other transaction since it was loaded.
+// After the update, the version of the loaded model is incremented.
+//
+// For example:
+//
+//	product, err := cql.Query[models.Product](ctx, db, conditions.Product.Code.Is().Eq(cql.Int(1))).FindOne()
+//
+//	_, err = cql.UpdateModel(ctx, db, product).Set(
+//		conditions.Product.Name.Set().Eq(cql.String("new name")),
+//	)
+//
+// For details see https://compiledquerylenguage.readthedocs.io/en/latest/cql/update.html#optimistic-locking
+func UpdateModel[T model.Model](ctx context.Context, tx *DB, loaded *T) *condition.Update[T] {
+	return condition.NewModelUpdate(
+		tx.gormDBWithContext(ctx),
+		loaded,
+	)
+}
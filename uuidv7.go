@@ -0,0 +1,31 @@
+package cql
+
+import (
+	"time"
+
+	"github.com/FrancoLiberali/cql/condition"
+	"github.com/FrancoLiberali/cql/model"
+)
+
+// UUIDv7Between allows filtering models by the time in which their version 7 uuid
+// (generated by model.NewUUIDv7, used by model.UUIDv7Model) was generated,
+// between from and to (both included, with millisecond precision).
+//
+// As version 7 uuids are time-ordered, the condition can use the index of the field.
+//
+// Example:
+//
+//	cql.Query[models.Order](
+//		ctx,
+//		db,
+//		cql.UUIDv7Between[models.Order](conditions.Order.ID, from, to),
+//	)
+//
+// translates as
+//
+// orders.id BETWEEN model.MinUUIDv7(from) AND model.MaxUUIDv7(to)
+//
+// Not supported by sqlserver, as uniqueidentifier values are not ordered by their timestamp.
+func UUIDv7Between[TObject model.Model](field condition.UUIDFieldOfModel[TObject], from, to time.Time) condition.WhereCondition[TObject] {
+	return condition.UUIDv7Between(field, from, to)
+}
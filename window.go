@@ -0,0 +1,80 @@
+package cql
+
+import (
+	"github.com/FrancoLiberali/cql/condition"
+)
+
+// Window functions
+// ref: https://www.postgresql.org/docs/current/functions-window.html
+
+// RowNumber is a window function that returns the number of the current row within its partition, counting from 1
+//
+// Example:
+//
+//	cql.RowNumber().PartitionBy(conditions.Product.String).Descending(conditions.Product.Int)
+func RowNumber() condition.WindowFunction[float64] {
+	return condition.RowNumber()
+}
+
+// Rank is a window function that returns the rank of the current row within its partition, with gaps
+//
+// Example:
+//
+//	cql.Rank().PartitionBy(conditions.Product.String).Descending(conditions.Product.Int)
+func Rank() condition.WindowFunction[float64] {
+	return condition.Rank()
+}
+
+// DenseRank is a window function that returns the rank of the current row within its partition, without gaps
+//
+// Example:
+//
+//	cql.DenseRank().PartitionBy(conditions.Product.String).Descending(conditions.Product.Int)
+func DenseRank() condition.WindowFunction[float64] {
+	return condition.DenseRank()
+}
+
+// Lag is a window function that returns value evaluated at the row that is offset rows before
+// the current row within its partition, or defaultValue if there is no such row
+//
+// Example:
+//
+//	cql.Lag(conditions.Product.Int, 1, cql.Int(0)).Ascending(conditions.Product.CreatedAt)
+func Lag[T any](value condition.ValueOfType[T], offset uint, defaultValue condition.ValueOfType[T]) condition.WindowFunction[T] {
+	return condition.Lag(value, offset, defaultValue)
+}
+
+// Lead is a window function that returns value evaluated at the row that is offset rows after
+// the current row within its partition, or defaultValue if there is no such row
+//
+// Example:
+//
+//	cql.Lead(conditions.Product.Int, 1, cql.Int(0)).Ascending(conditions.Product.CreatedAt)
+func Lead[T any](value condition.ValueOfType[T], offset uint, defaultValue condition.ValueOfType[T]) condition.WindowFunction[T] {
+	return condition.Lead(value, offset, defaultValue)
+}
+
+// UnboundedPreceding is a frame bound that starts the frame with the first row of the partition
+func UnboundedPreceding() condition.FrameBound {
+	return condition.UnboundedPreceding()
+}
+
+// Preceding is a frame bound that starts or ends the frame offset rows (or values if Range is used) before the current row
+func Preceding(offset uint) condition.FrameBound {
+	return condition.Preceding(offset)
+}
+
+// CurrentRow is a frame bound that starts or ends the frame with the current row
+func CurrentRow() condition.FrameBound {
+	return condition.CurrentRow()
+}
+
+// Following is a frame bound that starts or ends the frame offset rows (or values if Range is used) after the current row
+func Following(offset uint) condition.FrameBound {
+	return condition.Following(offset)
+}
+
+// UnboundedFollowing is a frame bound that ends the frame with the last row of the partition
+func UnboundedFollowing() condition.FrameBound {
+	return condition.UnboundedFollowing()
+}